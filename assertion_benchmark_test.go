@@ -0,0 +1,145 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	_ TestingT   = testing.TB(nil)
+	_ benchmarkT = (*testing.B)(nil)
+)
+
+// fakeB mimics a *testing.B that remembers whether it has failed.
+type fakeB struct {
+	outputT
+	failed bool
+}
+
+func (b *fakeB) Errorf(format string, args ...any) {
+	b.failed = true
+	b.outputT.Errorf(format, args...)
+}
+
+func (b *fakeB) Failed() bool {
+	return b.failed
+}
+
+func (b *fakeB) ReportAllocs() {}
+
+func TestFailReportsOncePerBenchmark(t *testing.T) {
+	b := &fakeB{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	failures := 0
+	mockAssertion := New(b).WithOnFailure(func(TestingT) { failures++ })
+	for i := 0; i < 3; i++ {
+		New(t).False(mockAssertion.Equal(1, 2))
+	}
+	New(t).Equal(3, failures)
+	New(t).Equal(1, strings.Count(b.buf.String(), "Not equal"))
+}
+
+func BenchmarkEqual(b *testing.B) {
+	a := New(b)
+	for i := 0; i < b.N; i++ {
+		a.Equal(i, i)
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	failures := 0
+	quiet := New(out).WithOnFailure(func(TestingT) { failures++ }).Quiet()
+
+	New(t).True(quiet.Equal(1, 1))
+	New(t).False(quiet.Equal(1, 2))
+	New(t).False(quiet.EqualValues(int32(1), int64(2)))
+	New(t).False(quiet.Contains("abc", "d"))
+	New(t).False(quiet.Fail("failure"))
+	New(t).Equal(0, failures)
+	New(t).Equal(0, out.buf.Len())
+
+	fatal := &fatalT{outputT{buf: bytes.NewBuffer(nil)}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		quiet := New(fatal).Quiet()
+		New(t).False(quiet.FailNow("failure"))
+		New(t).Equal(0, MustNoError(quiet, 0, errors.New("boom")))
+		New(t).Equal("", AsType[string](quiet, 42))
+		fatal.Errorf("not stopped")
+	}()
+	<-done
+	New(t).Equal("not stopped", fatal.buf.String())
+}
+
+func FuzzQuietEqual(f *testing.F) {
+	f.Add("tison")
+	f.Fuzz(func(t *testing.T, s string) {
+		a := New(t).Quiet()
+		if !a.Equal(s, string([]byte(s))) {
+			t.Fatalf("%q does not survive a byte round trip", s)
+		}
+	})
+}
+
+func TestEmptyAndLenFastPaths(t *testing.T) {
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	for _, object := range []any{
+		"", "a", true, false, 0, 1, int64(0), int64(1), 0.0, 1.5,
+		[]byte(nil), []byte("a"), []string{}, []string{"a"}, []int(nil), []int{1},
+		[]any{}, []any{nil}, map[string]string{}, map[string]string{"a": "b"},
+		map[string]any(nil), map[string]any{"a": 1}, make(chan struct{}), ch,
+	} {
+		v := reflect.ValueOf(object)
+		New(t).Equal(v.IsZero() || hasLen(v) && v.Len() == 0, isEmpty(object), "%#v", object)
+		if hasLen(v) {
+			ok, l := getLen(object)
+			New(t).True(ok)
+			New(t).Equal(v.Len(), l, "%#v", object)
+		}
+	}
+}
+
+func hasLen(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+func BenchmarkEmpty(b *testing.B) {
+	a := New(b)
+	values := []any{"", []byte(nil), []string{}, map[string]any{}, 0}
+	for i := 0; i < b.N; i++ {
+		a.Empty(values[i%len(values)])
+	}
+}
+
+func BenchmarkLen(b *testing.B) {
+	a := New(b)
+	values := []any{"abc", []byte("abc"), []string{"a", "b", "c"}, []int{1, 2, 3}}
+	for i := 0; i < b.N; i++ {
+		a.Len(values[i%len(values)], 3)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// Not inverts a value assertion: the returned assertion passes when the
+// given one fails, and fails when it passes. The inner assertion runs
+// quietly, so its own failure is never reported.
+//
+//	notEmpty := assert.Not((*assert.Assertions).Empty)
+//	notEmpty(a, config.Name)
+func Not(assertion ValueAssertionFunc) ValueAssertionFunc {
+	return func(a *Assertions, value any, msgAndArgs ...any) bool {
+		if disabled {
+			return true
+		}
+		if h, ok := a.t.(tHelper); ok {
+			h.Helper()
+		}
+		if assertion(a.Quiet(), value) {
+			return a.Fail(fmt.Sprintf("Not(%s): expected assertion to fail, but it passed for: %s", assertionName(assertion), truncatingFormat(value)), msgAndArgs...)
+		}
+		return true
+	}
+}
+
+// assertionName returns the short name of an assertion function, e.g.
+// "NotNil" for (*Assertions).NotNil.
+func assertionName(assertion any) string {
+	f := runtime.FuncForPC(reflect.ValueOf(assertion).Pointer())
+	if f == nil {
+		return "assertion"
+	}
+	name := strings.TrimSuffix(f.Name(), "-fm")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// All combines value assertions into one that passes when every one of them
+// passes for the value. All assertions are applied, and their failures are
+// reported together.
+//
+//	validID := assert.All((*assert.Assertions).NotEmpty, isUUID)
+func All(assertions ...ValueAssertionFunc) ValueAssertionFunc {
+	return func(a *Assertions, value any, msgAndArgs ...any) bool {
+		if disabled {
+			return true
+		}
+		if h, ok := a.t.(tHelper); ok {
+			h.Helper()
+		}
+		failed, results := applyAssertions(a, assertions, value)
+		if failed > 0 {
+			return a.Fail(fmt.Sprintf("All: %d of %d assertion(s) failed for: %s\n%s", failed, len(assertions), truncatingFormat(value), results), msgAndArgs...)
+		}
+		return true
+	}
+}
+
+// Any combines value assertions into one that passes when at least one of
+// them passes for the value. When none passes, the failures of all of them
+// are reported together.
+//
+//	emptyOrNil := assert.Any((*assert.Assertions).Nil, (*assert.Assertions).Empty)
+func Any(assertions ...ValueAssertionFunc) ValueAssertionFunc {
+	return func(a *Assertions, value any, msgAndArgs ...any) bool {
+		if disabled {
+			return true
+		}
+		if h, ok := a.t.(tHelper); ok {
+			h.Helper()
+		}
+		failed, results := applyAssertions(a, assertions, value)
+		if failed == len(assertions) {
+			return a.Fail(fmt.Sprintf("Any: none of %d assertion(s) passed for: %s\n%s", len(assertions), truncatingFormat(value), results), msgAndArgs...)
+		}
+		return true
+	}
+}
+
+// applyAssertions applies every assertion to value, recording their
+// failures instead of reporting them. It returns the number of failed
+// assertions and their failure messages labeled with the assertion names.
+func applyAssertions(a *Assertions, assertions []ValueAssertionFunc, value any) (int, string) {
+	failed := 0
+	var results []string
+	for _, assertion := range assertions {
+		assertion := assertion
+		failures := runRecorded(a, func(a *Assertions) {
+			assertion(a, value)
+		})
+		if len(failures) > 0 {
+			failed++
+			results = append(results, fmt.Sprintf("%s:\n%s", assertionName(assertion), strings.Join(failures, "\n")))
+		}
+	}
+	return failed, strings.Join(results, "\n")
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNot(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	notEmpty := Not((*Assertions).Empty)
+	New(t).True(notEmpty(mockAssertion, "value"))
+	New(t).False(notEmpty(mockAssertion, ""))
+	New(t).True(Not(Not((*Assertions).Empty))(mockAssertion, ""))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(notEmpty(NewWithOnFailureNoop(out), []int{}, "list of %s", "orders"))
+	New(t).Contains(out.buf.String(), "Not(Empty): expected assertion to fail, but it passed for: []int{}")
+	New(t).Contains(out.buf.String(), "list of orders")
+	New(t).NotContains(out.buf.String(), "Should be empty")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).True(Not((*Assertions).Nil)(NewWithOnFailureNoop(out), 42))
+	New(t).Equal(0, out.buf.Len())
+}
+
+func TestAll(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	nonEmptyString := All((*Assertions).NotEmpty, func(a *Assertions, value any, msgAndArgs ...any) bool {
+		return a.IsType("", value, msgAndArgs...)
+	})
+	New(t).True(nonEmptyString(mockAssertion, "value"))
+	New(t).False(nonEmptyString(mockAssertion, ""))
+	New(t).False(nonEmptyString(mockAssertion, 42))
+	New(t).True(All()(mockAssertion, nil))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(All((*Assertions).NotEmpty, (*Assertions).NotNil, (*Assertions).Nil)(New(out), []int{}, "orders"))
+	New(t).Contains(out.buf.String(), "All: 2 of 3 assertion(s) failed for: []int{}")
+	New(t).Contains(out.buf.String(), "NotEmpty:")
+	New(t).Contains(out.buf.String(), "Should NOT be empty")
+	New(t).Contains(out.buf.String(), "Nil:")
+	New(t).Contains(out.buf.String(), "Expected nil, but got: []int{}")
+	New(t).NotContains(out.buf.String(), "NotNil:")
+	New(t).Contains(out.buf.String(), "orders")
+}
+
+func TestAny(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	emptyOrNil := Any((*Assertions).Nil, (*Assertions).Empty)
+	New(t).True(emptyOrNil(mockAssertion, nil))
+	New(t).True(emptyOrNil(mockAssertion, ""))
+	New(t).False(emptyOrNil(mockAssertion, "value"))
+	New(t).False(Any()(mockAssertion, nil))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(emptyOrNil(New(out), 42))
+	New(t).Contains(out.buf.String(), "Any: none of 2 assertion(s) passed for: 42")
+	New(t).Contains(out.buf.String(), "Nil:")
+	New(t).Contains(out.buf.String(), "Empty:")
+}
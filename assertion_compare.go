@@ -17,6 +17,7 @@ package assert
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 )
@@ -338,11 +339,12 @@ func compare(obj1, obj2 any, kind reflect.Kind) (CompareType, bool) {
 
 			return compare(timeObj1.UnixNano(), timeObj2.UnixNano(), reflect.Int64)
 		}
-	case reflect.Slice:
+	case reflect.Slice, reflect.Array:
 		{
-			// We only care about the []byte type.
-			if !canConvert(obj1Value, bytesType) {
-				break
+			// []byte has a fast path, other slices and arrays are compared
+			// element by element.
+			if kind != reflect.Slice || !canConvert(obj1Value, bytesType) {
+				return compareLexicographically(obj1Value, obj2Value)
 			}
 
 			// []byte can be compared!
@@ -363,8 +365,43 @@ func compare(obj1, obj2 any, kind reflect.Kind) (CompareType, bool) {
 	return compareEqual, false
 }
 
-// Greater asserts that the first element is greater than the second
+// compareLexicographically compares two slices or arrays of the same type
+// like bytes.Compare does: by the first differing element, or else by
+// length. It reports false if the elements cannot be ordered.
+func compareLexicographically(v1, v2 reflect.Value) (CompareType, bool) {
+	if v1.Type() != v2.Type() {
+		return compareEqual, false
+	}
+	elemType := v1.Type().Elem()
+	elemKind := elemType.Kind()
+	zero := reflect.Zero(elemType).Interface()
+	if _, ok := compare(zero, zero, elemKind); !ok {
+		return compareEqual, false
+	}
+
+	for i := 0; i < v1.Len() && i < v2.Len(); i++ {
+		c, ok := compare(v1.Index(i).Interface(), v2.Index(i).Interface(), elemKind)
+		if !ok {
+			return compareEqual, false
+		}
+		if c != compareEqual {
+			return c, true
+		}
+	}
+	return compare(v1.Len(), v2.Len(), reflect.Int)
+}
+
+// Greater asserts that the first element is greater than the second.
+// Slices and arrays of the same type are compared lexicographically.
 func (a *Assertions) Greater(e1 any, e2 any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Greater", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return a.Greater(e1, e2, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -373,14 +410,31 @@ func (a *Assertions) Greater(e1 any, e2 any, msgAndArgs ...any) bool {
 
 // GreaterOrEqual asserts that the first element is greater than or equal to the second
 func (a *Assertions) GreaterOrEqual(e1 any, e2 any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("GreaterOrEqual", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return a.GreaterOrEqual(e1, e2, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 	return a.compareTwoValues(e1, e2, []CompareType{compareGreater, compareEqual}, "\"%v\" is not greater than or equal to \"%v\"", msgAndArgs...)
 }
 
-// Less asserts that the first element is less than the second
+// Less asserts that the first element is less than the second.
+// Slices and arrays of the same type are compared lexicographically.
 func (a *Assertions) Less(e1 any, e2 any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Less", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return a.Less(e1, e2, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -389,6 +443,14 @@ func (a *Assertions) Less(e1 any, e2 any, msgAndArgs ...any) bool {
 
 // LessOrEqual asserts that the first element is less than or equal to the second
 func (a *Assertions) LessOrEqual(e1 any, e2 any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("LessOrEqual", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return a.LessOrEqual(e1, e2, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -397,6 +459,14 @@ func (a *Assertions) LessOrEqual(e1 any, e2 any, msgAndArgs ...any) bool {
 
 // Positive asserts that the specified element is positive
 func (a *Assertions) Positive(e any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Positive", []any{e, msgAndArgs}, func(a *Assertions) bool {
+			return a.Positive(e, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -406,6 +476,14 @@ func (a *Assertions) Positive(e any, msgAndArgs ...any) bool {
 
 // Negative asserts that the specified element is negative
 func (a *Assertions) Negative(e any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Negative", []any{e, msgAndArgs}, func(a *Assertions) bool {
+			return a.Negative(e, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -418,22 +496,83 @@ func (a *Assertions) compareTwoValues(e1 any, e2 any, allowedComparesResults []C
 		h.Helper()
 	}
 
+	compareResult, ok := a.compareValues(e1, e2, msgAndArgs...)
+	if !ok {
+		return false
+	}
+
+	if !containsValue(allowedComparesResults, compareResult) {
+		return a.Fail(fmt.Sprintf(failMessage, formatCompareValue(e1), formatCompareValue(e2)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// compareValues compares e1 with e2, and fails if they cannot be compared.
+func (a *Assertions) compareValues(e1 any, e2 any, msgAndArgs ...any) (CompareType, bool) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	c1, c2 := e1, e2
 	e1Kind := reflect.ValueOf(e1).Kind()
 	e2Kind := reflect.ValueOf(e2).Kind()
 	if e1Kind != e2Kind {
-		return a.Fail("Elements should be the same type", msgAndArgs...)
+		var ok bool
+		if c1, c2, e1Kind, ok = widenIntegers(e1, e2); !ok {
+			return 0, a.Fail(fmt.Sprintf("Elements should be the same type: cannot compare %T(%#v) with %T(%#v)", e1, e1, e2, e2), msgAndArgs...)
+		}
 	}
 
-	compareResult, isComparable := compare(e1, e2, e1Kind)
+	compareResult, isComparable := compare(c1, c2, e1Kind)
 	if !isComparable {
-		return a.Fail(fmt.Sprintf("Can not compare type \"%s\"", reflect.TypeOf(e1)), msgAndArgs...)
+		return 0, a.Fail(fmt.Sprintf("Can not compare type \"%s\"", reflect.TypeOf(e1)), msgAndArgs...)
 	}
 
-	if !containsValue(allowedComparesResults, compareResult) {
-		return a.Fail(fmt.Sprintf(failMessage, e1, e2), msgAndArgs...)
+	return compareResult, true
+}
+
+// formatCompareValue formats durations and times in comparison failures in
+// a human-readable form, such as 1.5s and RFC 3339, instead of whatever %v
+// makes of the underlying integer or struct.
+func formatCompareValue(v any) any {
+	switch v := v.(type) {
+	case time.Duration:
+		return v.String()
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
 	}
+	return v
+}
 
-	return true
+// widenIntegers converts integers of different kinds but the same
+// signedness to int64 or uint64, so that e.g. an int64 can be compared with
+// an untyped constant or a len() result.
+func widenIntegers(e1, e2 any) (any, any, reflect.Kind, bool) {
+	v1, v2 := reflect.ValueOf(e1), reflect.ValueOf(e2)
+	switch {
+	case isSignedInteger(v1.Kind()) && isSignedInteger(v2.Kind()):
+		return v1.Int(), v2.Int(), reflect.Int64, true
+	case isUnsignedInteger(v1.Kind()) && isUnsignedInteger(v2.Kind()):
+		return v1.Uint(), v2.Uint(), reflect.Uint64, true
+	}
+	return e1, e2, reflect.Invalid, false
+}
+
+func isSignedInteger(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUnsignedInteger(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
 }
 
 func containsValue(values []CompareType, value CompareType) bool {
@@ -451,3 +590,132 @@ func containsValue(values []CompareType, value CompareType) bool {
 func canConvert(value reflect.Value, to reflect.Type) bool {
 	return value.CanConvert(to)
 }
+
+// distance returns how far e1 is from e2 for numbers, durations and times,
+// formatted for failure messages, or false for other types.
+func distance(e1, e2 any) (string, bool) {
+	if t1, ok := e1.(time.Time); ok {
+		if t2, ok := e2.(time.Time); ok {
+			return absDuration(t1.Sub(t2)).String(), true
+		}
+		return "", false
+	}
+	if d1, ok := e1.(time.Duration); ok {
+		if d2, ok := e2.(time.Duration); ok {
+			return absDuration(d1 - d2).String(), true
+		}
+		return "", false
+	}
+
+	v1, v2 := reflect.ValueOf(e1), reflect.ValueOf(e2)
+	switch {
+	case isSignedInteger(v1.Kind()) && isSignedInteger(v2.Kind()):
+		i1, i2 := v1.Int(), v2.Int()
+		if i1 < i2 {
+			i1, i2 = i2, i1
+		}
+		return fmt.Sprint(uint64(i1) - uint64(i2)), true
+	case isUnsignedInteger(v1.Kind()) && isUnsignedInteger(v2.Kind()):
+		u1, u2 := v1.Uint(), v2.Uint()
+		if u1 < u2 {
+			u1, u2 = u2, u1
+		}
+		return fmt.Sprint(u1 - u2), true
+	case isFloat(v1.Kind()) && isFloat(v2.Kind()):
+		return fmt.Sprint(math.Abs(v1.Float() - v2.Float())), true
+	}
+	return "", false
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func isFloat(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// inRange asserts that min <= value <= max, or min < value < max if open.
+func (a *Assertions) inRange(value, min, max any, open bool, msgAndArgs ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	interval := fmt.Sprintf("[%v, %v]", formatCompareValue(min), formatCompareValue(max))
+	if open {
+		interval = fmt.Sprintf("(%v, %v)", formatCompareValue(min), formatCompareValue(max))
+	}
+
+	belowMin, ok := a.compareValues(value, min, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	aboveMax, ok := a.compareValues(value, max, msgAndArgs...)
+	if !ok {
+		return false
+	}
+
+	var violation, bound string
+	var boundValue any
+	switch {
+	case belowMin == compareLess:
+		violation, bound, boundValue = "below", "minimum", min
+	case open && belowMin == compareEqual:
+		violation, bound = "equal to", "minimum"
+	case aboveMax == compareGreater:
+		violation, bound, boundValue = "above", "maximum", max
+	case open && aboveMax == compareEqual:
+		violation, bound = "equal to", "maximum"
+	default:
+		return true
+	}
+
+	msg := fmt.Sprintf("\"%v\" is not in range %s: %s the %s", formatCompareValue(value), interval, violation, bound)
+	if boundValue != nil {
+		if d, ok := distance(value, boundValue); ok {
+			msg += " by " + d
+		}
+	}
+	return a.Fail(msg, msgAndArgs...)
+}
+
+// InRange asserts that min <= value <= max. It works for the same types as
+// Greater and Less, and reports which bound is violated and by how much.
+//
+//	a.InRange(latency, 10*time.Millisecond, 250*time.Millisecond)
+func (a *Assertions) InRange(value, min, max any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InRange", []any{value, min, max, msgAndArgs}, func(a *Assertions) bool {
+			return a.InRange(value, min, max, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.inRange(value, min, max, false, msgAndArgs...)
+}
+
+// InOpenRange asserts that min < value < max. It works for the same types
+// as Greater and Less, and reports which bound is violated and by how much.
+//
+//	a.InOpenRange(ratio, 0.0, 1.0)
+func (a *Assertions) InOpenRange(value, min, max any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InOpenRange", []any{value, min, max, msgAndArgs}, func(a *Assertions) bool {
+			return a.InOpenRange(value, min, max, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.inRange(value, min, max, true, msgAndArgs...)
+}
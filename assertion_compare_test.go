@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !assert_disabled
+
 package assert
 
 import (
@@ -163,7 +165,7 @@ func TestGreater(t *testing.T) {
 		{less: float32(1.23), greater: float32(2.34), msg: `"1.23" is not greater than "2.34"`},
 		{less: 1.23, greater: 2.34, msg: `"1.23" is not greater than "2.34"`},
 		{less: []byte{1, 1}, greater: []byte{1, 2}, msg: `"[1 1]" is not greater than "[1 2]"`},
-		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01 00:00:00 +0000 UTC" is not greater than "0001-01-01 01:00:00 +0000 UTC"`},
+		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01T00:00:00Z" is not greater than "0001-01-01T01:00:00Z"`},
 	} {
 		out := &outputT{buf: bytes.NewBuffer(nil)}
 		outAssertion := New(out)
@@ -198,7 +200,7 @@ func TestGreaterOrEqual(t *testing.T) {
 		{less: float32(1.23), greater: float32(2.34), msg: `"1.23" is not greater than or equal to "2.34"`},
 		{less: 1.23, greater: 2.34, msg: `"1.23" is not greater than or equal to "2.34"`},
 		{less: []byte{1, 1}, greater: []byte{1, 2}, msg: `"[1 1]" is not greater than or equal to "[1 2]"`},
-		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01 00:00:00 +0000 UTC" is not greater than or equal to "0001-01-01 01:00:00 +0000 UTC"`},
+		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01T00:00:00Z" is not greater than or equal to "0001-01-01T01:00:00Z"`},
 	} {
 		out := &outputT{buf: bytes.NewBuffer(nil)}
 		outAssertion := New(out)
@@ -233,7 +235,7 @@ func TestLess(t *testing.T) {
 		{less: float32(1.23), greater: float32(2.34), msg: `"2.34" is not less than "1.23"`},
 		{less: 1.23, greater: 2.34, msg: `"2.34" is not less than "1.23"`},
 		{less: []byte{1, 1}, greater: []byte{1, 2}, msg: `"[1 2]" is not less than "[1 1]"`},
-		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01 01:00:00 +0000 UTC" is not less than "0001-01-01 00:00:00 +0000 UTC"`},
+		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01T01:00:00Z" is not less than "0001-01-01T00:00:00Z"`},
 	} {
 		out := &outputT{buf: bytes.NewBuffer(nil)}
 		outAssertion := New(out)
@@ -268,7 +270,7 @@ func TestLessOrEqual(t *testing.T) {
 		{less: float32(1.23), greater: float32(2.34), msg: `"2.34" is not less than or equal to "1.23"`},
 		{less: 1.23, greater: 2.34, msg: `"2.34" is not less than or equal to "1.23"`},
 		{less: []byte{1, 1}, greater: []byte{1, 2}, msg: `"[1 2]" is not less than or equal to "[1 1]"`},
-		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01 01:00:00 +0000 UTC" is not less than or equal to "0001-01-01 00:00:00 +0000 UTC"`},
+		{less: time.Time{}, greater: time.Time{}.Add(time.Hour), msg: `"0001-01-01T01:00:00Z" is not less than or equal to "0001-01-01T00:00:00Z"`},
 	} {
 		out := &outputT{buf: bytes.NewBuffer(nil)}
 		outAssertion := New(out)
@@ -352,6 +354,52 @@ func Test_compareTwoValuesDifferentValuesTypes(t *testing.T) {
 	}
 }
 
+func Test_compareTwoValuesMixedIntegerKinds(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.Greater(int64(3), 2))
+	New(t).True(mockAssertion.Less(int32(-1), int64(0)))
+	New(t).True(mockAssertion.GreaterOrEqual(uint8(255), uint64(255)))
+	New(t).True(mockAssertion.LessOrEqual(uint(1), uint32(2)))
+	New(t).True(mockAssertion.Greater(int64(1)<<40, int32(1)))
+	New(t).False(mockAssertion.Greater(int8(1), int64(2)))
+	New(t).False(mockAssertion.Greater(int64(1), uint64(0)), "signedness differs")
+	New(t).False(mockAssertion.Greater(int64(1), float64(0)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Less(int64(5), 3))
+	New(t).Contains(out.buf.String(), `"5" is not less than "3"`)
+}
+
+func Test_compareTwoValuesFormatsDurationsAndTimes(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Greater(time.Second, 1500*time.Millisecond))
+	New(t).Contains(out.buf.String(), `"1s" is not greater than "1.5s"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Positive(-2 * time.Minute))
+	New(t).Contains(out.buf.String(), `"-2m0s" is not positive`)
+
+	early := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Less(early.Add(time.Hour), early))
+	New(t).Contains(out.buf.String(), `"2022-01-02T04:04:05Z" is not less than "2022-01-02T03:04:05Z"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).IsIncreasing([]time.Duration{time.Second, time.Millisecond}))
+	New(t).Contains(out.buf.String(), `"1s" is not less than "1ms"`)
+}
+
+func Test_compareTwoValuesDifferentTypesMessage(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Greater(3, "3"))
+	New(t).Contains(out.buf.String(), `Elements should be the same type: cannot compare int(3) with string("3")`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Less(int64(1), uint64(2)))
+	New(t).Contains(out.buf.String(), "cannot compare int64(1) with uint64(0x2)")
+}
+
 func Test_compareTwoValuesNotComparableValues(t *testing.T) {
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
 	type CompareStruct struct{}
@@ -361,12 +409,32 @@ func Test_compareTwoValuesNotComparableValues(t *testing.T) {
 	}{
 		{v1: CompareStruct{}, v2: CompareStruct{}},
 		{v1: map[string]int{}, v2: map[string]int{}},
-		{v1: make([]int, 5), v2: make([]int, 5)},
+		{v1: make([]map[string]int, 5), v2: make([]map[string]int, 5)},
+		{v1: []CompareStruct{}, v2: []CompareStruct{}},
+		{v1: []int{1}, v2: []int64{1}},
 	} {
 		New(t).False(mockAssertion.compareTwoValues(currCase.v1, currCase.v2, []CompareType{compareLess, compareEqual, compareGreater}, "testFailMessage"))
 	}
 }
 
+func TestCompareSlicesLexicographically(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.Less([]int{1, 2, 3}, []int{1, 3}))
+	New(t).True(mockAssertion.Less([]int{1, 2}, []int{1, 2, 0}))
+	New(t).True(mockAssertion.Greater([]string{"b"}, []string{"a", "z"}))
+	New(t).True(mockAssertion.GreaterOrEqual([]uint{4, 2}, []uint{4, 2}))
+	New(t).True(mockAssertion.LessOrEqual([]int{}, []int{}))
+	New(t).True(mockAssertion.Less([2]int{1, 2}, [2]int{2, 0}))
+	New(t).True(mockAssertion.Less([][]int{{1}, {2}}, [][]int{{1}, {2, 0}}))
+	New(t).True(mockAssertion.Greater([]time.Duration{time.Second}, []time.Duration{time.Millisecond}))
+	New(t).False(mockAssertion.Greater([]int{1, 2}, []int{1, 2}))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Greater([]int{1, 2}, []int{1, 3}))
+	New(t).Contains(out.buf.String(), `"[1 2]" is not greater than "[1 3]"`)
+}
+
 func Test_compareTwoValuesCorrectCompareResult(t *testing.T) {
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
 	for _, currCase := range []struct {
@@ -419,3 +487,55 @@ func TestComparingMsgAndArgsForwarding(t *testing.T) {
 		New(t).Contains(out.buf.String(), expectedOutput)
 	}
 }
+
+func TestInRange(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.InRange(5, 1, 10))
+	New(t).True(mockAssertion.InRange(1, 1, 10))
+	New(t).True(mockAssertion.InRange(10, 1, 10))
+	New(t).True(mockAssertion.InRange(int64(5), 1, 10))
+	New(t).True(mockAssertion.InRange(0.5, 0.0, 1.0))
+	New(t).True(mockAssertion.InRange("b", "a", "c"))
+	New(t).True(mockAssertion.InRange(time.Second, time.Millisecond, time.Minute))
+	New(t).False(mockAssertion.InRange(0, 1, 10))
+	New(t).False(mockAssertion.InRange(11, 1, 10))
+	New(t).False(mockAssertion.InRange(5, "1", 10))
+
+	cases := []struct {
+		value, min, max any
+		msg             string
+	}{
+		{-3, 1, 10, `"-3" is not in range [1, 10]: below the minimum by 4`},
+		{uint8(12), uint8(1), uint8(10), `"12" is not in range [1, 10]: above the maximum by 2`},
+		{1.5, 0.0, 1.0, `"1.5" is not in range [0, 1]: above the maximum by 0.5`},
+		{300 * time.Millisecond, 10 * time.Millisecond, 250 * time.Millisecond, `"300ms" is not in range [10ms, 250ms]: above the maximum by 50ms`},
+		{"z", "a", "c", `"z" is not in range [a, c]: above the maximum`},
+	}
+	for _, c := range cases {
+		out := &outputT{buf: bytes.NewBuffer(nil)}
+		New(t).False(NewWithOnFailureNoop(out).InRange(c.value, c.min, c.max))
+		New(t).Contains(out.buf.String(), c.msg)
+	}
+
+	early := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).InRange(early, early.Add(time.Hour), early.Add(2*time.Hour)))
+	New(t).Contains(out.buf.String(), `"2022-01-02T03:04:05Z" is not in range [2022-01-02T04:04:05Z, 2022-01-02T05:04:05Z]: below the minimum by 1h0m0s`)
+}
+
+func TestInOpenRange(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.InOpenRange(5, 1, 10))
+	New(t).False(mockAssertion.InOpenRange(1, 1, 10))
+	New(t).False(mockAssertion.InOpenRange(10, 1, 10))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).InOpenRange(0.0, 0.0, 1.0))
+	New(t).Contains(out.buf.String(), `"0" is not in range (0, 1): equal to the minimum`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).InOpenRange(12, 1, 10))
+	New(t).Contains(out.buf.String(), `"12" is not in range (1, 10): above the maximum by 2`)
+}
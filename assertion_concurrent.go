@@ -0,0 +1,243 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Concurrently runs body in n goroutines and waits for all of them to
+// complete. Each goroutine receives its index and an Assertions derived from
+// a that records failures instead of reporting them; once every goroutine
+// has finished, the recorded failures are reported as a single failure
+// labeled with the goroutine indices. A panic in body is reported as a
+// failure of its goroutine.
+//
+// A failure that triggers FailNow in the derived Assertions (the default
+// behaviour of New) stops only the goroutine that failed.
+func (a *Assertions) Concurrently(n int, body func(i int, a *Assertions), msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Concurrently", []any{n, body, msgAndArgs}, func(a *Assertions) bool {
+			return a.Concurrently(n, body, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	recorders := make([]*recordingT, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		recorders[i] = &recordingT{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if funcDidPanic, panicValue, panickedStack := didPanic(func() {
+				body(i, a.recordTo(recorders[i]))
+			}); funcDidPanic {
+				recorders[i].Errorf("Panic value:\t%v\nPanic stack:\t%s", panicValue, panickedStack)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var failed []string
+	for i, r := range recorders {
+		for _, msg := range r.failures() {
+			failed = append(failed, fmt.Sprintf("goroutine %d:\n%s", i, msg))
+		}
+	}
+
+	if len(failed) > 0 {
+		return a.Fail(fmt.Sprintf("%d failure(s) in %d goroutine(s):\n%s", len(failed), n, strings.Join(failed, "\n")), msgAndArgs...)
+	}
+
+	return true
+}
+
+// maxReportedPanics bounds the number of panics NoRaceUnderStress renders in
+// its failure message.
+const maxReportedPanics = 10
+
+// NoRaceUnderStress runs all fns concurrently, one goroutine each, for the
+// given number of iterations and asserts that none of them panics. It is
+// meant to be run under the race detector (go test -race) to shake out data
+// races in concurrent data structures.
+func (a *Assertions) NoRaceUnderStress(iterations int, fns ...func()) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoRaceUnderStress", []any{iterations, fns}, func(a *Assertions) bool {
+			return a.NoRaceUnderStress(iterations, fns...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	var mu sync.Mutex
+	var panics []string
+	for i := 0; i < iterations; i++ {
+		var wg sync.WaitGroup
+		for j, fn := range fns {
+			wg.Add(1)
+			go func(j int, fn func()) {
+				defer wg.Done()
+				if funcDidPanic, panicValue, panickedStack := didPanic(fn); funcDidPanic {
+					mu.Lock()
+					defer mu.Unlock()
+					panics = append(panics, fmt.Sprintf("iteration %d, func %d:\nPanic value:\t%v\nPanic stack:\t%s", i, j, panicValue, panickedStack))
+				}
+			}(j, fn)
+		}
+		wg.Wait()
+	}
+
+	if len(panics) > 0 {
+		reported := panics
+		if len(reported) > maxReportedPanics {
+			reported = reported[:maxReportedPanics]
+		}
+		msg := fmt.Sprintf("%d panic(s) in %d iteration(s):\n%s", len(panics), iterations, strings.Join(reported, "\n"))
+		if len(panics) > len(reported) {
+			msg += fmt.Sprintf("\n... and %d more", len(panics)-len(reported))
+		}
+		return a.Fail(msg)
+	}
+
+	return true
+}
+
+// settlesWithin reports whether wait returns within timeout. If it does
+// not, wait keeps running in its goroutine.
+func (a *Assertions) settlesWithin(wait func(), timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wait()
+	}()
+
+	timer := a.clock.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C():
+		return false
+	}
+}
+
+// WaitGroupDoneWithin asserts that wg is done within timeout, and reports
+// the stacks of all goroutines if it is not, so that a hang shows what is
+// still running instead of ending in the test timeout.
+//
+//	a.WaitGroupDoneWithin(&wg, time.Second)
+func (a *Assertions) WaitGroupDoneWithin(wg *sync.WaitGroup, timeout time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("WaitGroupDoneWithin", []any{wg, timeout, msgAndArgs}, func(a *Assertions) bool {
+			return a.WaitGroupDoneWithin(wg, timeout, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !a.settlesWithin(wg.Wait, timeout) {
+		return a.Fail(fmt.Sprintf("WaitGroup is not done within %v\nGoroutines:\n%s", timeout, allGoroutineStacks()), msgAndArgs...)
+	}
+
+	return true
+}
+
+// MutexUnlockedWithin asserts that m can be locked within timeout, i.e.
+// that whoever holds it releases it in time, and reports the stacks of all
+// goroutines if it cannot. The lock is released again right away; if the
+// assertion fails, it is acquired and released whenever m becomes free.
+//
+//	a.MutexUnlockedWithin(&cache.mu, 100*time.Millisecond)
+func (a *Assertions) MutexUnlockedWithin(m sync.Locker, timeout time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("MutexUnlockedWithin", []any{m, timeout, msgAndArgs}, func(a *Assertions) bool {
+			return a.MutexUnlockedWithin(m, timeout, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !a.settlesWithin(func() {
+		m.Lock()
+		m.Unlock()
+	}, timeout) {
+		return a.Fail(fmt.Sprintf("Mutex is not unlocked within %v\nGoroutines:\n%s", timeout, allGoroutineStacks()), msgAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorGroup is implemented by *errgroup.Group from golang.org/x/sync, and
+// by anything else that waits for a group of goroutines and returns their
+// first error.
+type ErrorGroup interface {
+	Wait() error
+}
+
+// NoErrorGroup waits for g within timeout and asserts that it returns no
+// error. If the wait times out, the stacks of all goroutines are reported.
+//
+//	var g errgroup.Group
+//	for _, url := range urls {
+//		url := url
+//		g.Go(func() error { return fetch(url) })
+//	}
+//	a.NoErrorGroup(&g, 5*time.Second)
+func (a *Assertions) NoErrorGroup(g ErrorGroup, timeout time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoErrorGroup", []any{g, timeout, msgAndArgs}, func(a *Assertions) bool {
+			return a.NoErrorGroup(g, timeout, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	var err error
+	if !a.settlesWithin(func() {
+		err = g.Wait()
+	}, timeout) {
+		return a.Fail(fmt.Sprintf("Group is not done within %v\nGoroutines:\n%s", timeout, allGoroutineStacks()), msgAndArgs...)
+	}
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Group returned unexpected error:\n%+v", err), msgAndArgs...)
+	}
+
+	return true
+}
@@ -0,0 +1,183 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrently(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	var count int64
+	New(t).True(mockAssertion.Concurrently(8, func(i int, a *Assertions) {
+		atomic.AddInt64(&count, 1)
+		a.GreaterOrEqual(i, 0)
+	}))
+	New(t).Equal(int64(8), count)
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Concurrently(4, func(i int, a *Assertions) {
+		a.NotEqual(2, i, "index %d", i)
+	}))
+	New(t).Contains(out.buf.String(), "1 failure(s) in 4 goroutine(s)")
+	New(t).Contains(out.buf.String(), "goroutine 2:")
+	New(t).Contains(out.buf.String(), "index 2")
+	New(t).NotContains(out.buf.String(), "goroutine 1:")
+}
+
+func TestConcurrentlyFailNow(t *testing.T) {
+	var reached int64
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(New(out).Concurrently(2, func(i int, a *Assertions) {
+		a.True(i != 0)
+		atomic.AddInt64(&reached, 1)
+	}))
+	New(t).Equal(int64(1), reached, "failing goroutine should stop at FailNow")
+	New(t).Contains(out.buf.String(), "goroutine 0:")
+}
+
+func TestConcurrentlyPanics(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Concurrently(3, func(i int, a *Assertions) {
+		if i == 1 {
+			panic("boom")
+		}
+	}))
+	New(t).Contains(out.buf.String(), "goroutine 1:")
+	New(t).Contains(out.buf.String(), "Panic value:\tboom")
+}
+
+func TestNoRaceUnderStress(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	var count int64
+	inc := func() { atomic.AddInt64(&count, 1) }
+	New(t).True(mockAssertion.NoRaceUnderStress(10, inc, inc, inc))
+	New(t).Equal(int64(30), count)
+	New(t).True(mockAssertion.NoRaceUnderStress(10))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NoRaceUnderStress(20, inc, func() {
+		panic("boom")
+	}))
+	New(t).Contains(out.buf.String(), "20 panic(s) in 20 iteration(s)")
+	New(t).Contains(out.buf.String(), "func 1:")
+	New(t).Contains(out.buf.String(), "Panic value:\tboom")
+	New(t).Contains(out.buf.String(), "... and 10 more")
+}
+
+func TestWaitGroupDoneWithin(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	var wg sync.WaitGroup
+	New(t).True(mockAssertion.WaitGroupDoneWithin(&wg, time.Millisecond))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+	}()
+	New(t).True(mockAssertion.WaitGroupDoneWithin(&wg, 10*time.Second))
+
+	release := make(chan struct{})
+	defer close(release)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-release
+	}()
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WaitGroupDoneWithin(&wg, 10*time.Millisecond, "fan-out"))
+	New(t).Contains(out.buf.String(), "WaitGroup is not done within 10ms")
+	New(t).Contains(out.buf.String(), "TestWaitGroupDoneWithin.func2")
+	New(t).Contains(out.buf.String(), "fan-out")
+}
+
+func TestMutexUnlockedWithin(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	var mu sync.Mutex
+	New(t).True(mockAssertion.MutexUnlockedWithin(&mu, time.Second))
+
+	var rw sync.RWMutex
+	rw.RLock()
+	New(t).True(mockAssertion.MutexUnlockedWithin(rw.RLocker(), time.Second))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).MutexUnlockedWithin(&rw, 10*time.Millisecond))
+	New(t).Contains(out.buf.String(), "Mutex is not unlocked within 10ms")
+	New(t).Contains(out.buf.String(), "Goroutines:")
+	rw.RUnlock()
+}
+
+// errGroup is a minimal stand-in for errgroup.Group.
+type errGroup struct {
+	wg   sync.WaitGroup
+	once sync.Once
+	err  error
+}
+
+func (g *errGroup) Go(f func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := f(); err != nil {
+			g.once.Do(func() { g.err = err })
+		}
+	}()
+}
+
+func (g *errGroup) Wait() error {
+	g.wg.Wait()
+	return g.err
+}
+
+func TestNoErrorGroup(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	g := &errGroup{}
+	for i := 0; i < 4; i++ {
+		g.Go(func() error { return nil })
+	}
+	New(t).True(mockAssertion.NoErrorGroup(g, 10*time.Second))
+
+	g = &errGroup{}
+	g.Go(func() error { return nil })
+	g.Go(func() error { return errors.New("fetch failed") })
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NoErrorGroup(g, 10*time.Second))
+	New(t).Contains(out.buf.String(), "Group returned unexpected error:")
+	New(t).Contains(out.buf.String(), "fetch failed")
+
+	release := make(chan struct{})
+	defer close(release)
+	g = &errGroup{}
+	g.Go(func() error {
+		<-release
+		return nil
+	})
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NoErrorGroup(g, 10*time.Millisecond))
+	New(t).Contains(out.buf.String(), "Group is not done within 10ms")
+	New(t).Contains(out.buf.String(), "Goroutines:")
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxDeepSearchDepth bounds how deep DeepContains descends.
+	maxDeepSearchDepth = 32
+	// maxNearMisses bounds the near-misses DeepContains reports.
+	maxNearMisses = 3
+)
+
+// deepSearch walks a value looking for needle, recording the paths it is
+// found at and the paths of values of the same type as near-misses.
+type deepSearch struct {
+	needle     any
+	needleType reflect.Type
+	found      []string
+	nearMisses []string
+	visited    map[uintptr]bool
+}
+
+func (s *deepSearch) walk(v reflect.Value, path string, depth int) {
+	if !v.IsValid() || depth > maxDeepSearchDepth {
+		return
+	}
+
+	if v.CanInterface() {
+		if ObjectsAreEqual(s.needle, v.Interface()) {
+			s.found = append(s.found, path)
+			return
+		}
+		if v.Type() == s.needleType && len(s.nearMisses) < maxNearMisses {
+			s.nearMisses = append(s.nearMisses, fmt.Sprintf("%s: %s", path, truncatingFormat(v.Interface())))
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Ptr {
+			if s.visited[v.Pointer()] {
+				return
+			}
+			s.visited[v.Pointer()] = true
+		}
+		s.walk(v.Elem(), path, depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				s.walk(v.Field(i), path+"."+v.Type().Field(i).Name, depth+1)
+			}
+		}
+	case reflect.Map:
+		for _, k := range sortedMapKeys(v) {
+			s.walk(v.MapIndex(k), fmt.Sprintf("%s[%#v]", path, k.Interface()), depth+1)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			s.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i), depth+1)
+		}
+	}
+}
+
+// sortedMapKeys returns the keys of a map in a stable order, so that the
+// reported paths do not depend on map iteration order.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%#v", keys[i].Interface()) < fmt.Sprintf("%#v", keys[j].Interface())
+	})
+	return keys
+}
+
+// DeepContains asserts that needle is equal to some value nested in
+// haystack, searching recursively through pointers, exported struct fields,
+// map values and slice or array elements. It returns the paths needle was
+// found at, such as "$.Spec.Containers[0].Image". When needle is not found,
+// values of the same type are reported with their paths as near-misses.
+//
+//	paths, ok := a.DeepContains(resp, "admin@example.com")
+func (a *Assertions) DeepContains(haystack, needle any, msgAndArgs ...any) ([]string, bool) {
+	if disabled {
+		return nil, true
+	}
+	if a.interceptors != nil {
+		var paths []string
+		ok := a.intercept("DeepContains", []any{haystack, needle, msgAndArgs}, func(a *Assertions) bool {
+			var ok bool
+			paths, ok = a.DeepContains(haystack, needle, msgAndArgs...)
+			return ok
+		})
+		return paths, ok
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	s := &deepSearch{needle: needle, needleType: reflect.TypeOf(needle), visited: map[uintptr]bool{}}
+	s.walk(reflect.ValueOf(haystack), "$", 0)
+	if len(s.found) > 0 {
+		return s.found, true
+	}
+
+	msg := fmt.Sprintf("%s does not contain %s at any depth", truncatingFormat(haystack), truncatingFormat(needle))
+	if len(s.nearMisses) > 0 {
+		msg += "\nnear-misses:\n\t" + strings.Join(s.nearMisses, "\n\t")
+	}
+	return nil, a.Fail(msg, msgAndArgs...)
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+type deepContainer struct {
+	Name  string
+	Image string
+	Ports []int
+}
+
+type deepPayload struct {
+	Spec struct {
+		Containers []deepContainer
+		Labels     map[string]string
+	}
+	Owner  *deepContainer
+	secret string
+}
+
+func newDeepPayload() deepPayload {
+	var p deepPayload
+	p.Spec.Containers = []deepContainer{
+		{Name: "app", Image: "app:1.0", Ports: []int{8080}},
+		{Name: "sidecar", Image: "proxy:2.1", Ports: []int{9090, 8080}},
+	}
+	p.Spec.Labels = map[string]string{"tier": "web", "team": "app"}
+	p.Owner = &deepContainer{Name: "ops"}
+	p.secret = "hidden"
+	return p
+}
+
+func TestDeepContains(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	p := newDeepPayload()
+
+	paths, ok := mockAssertion.DeepContains(p, "proxy:2.1")
+	New(t).True(ok)
+	New(t).Equal([]string{"$.Spec.Containers[1].Image"}, paths)
+
+	paths, ok = mockAssertion.DeepContains(p, 8080)
+	New(t).True(ok)
+	New(t).Equal([]string{"$.Spec.Containers[0].Ports[0]", "$.Spec.Containers[1].Ports[1]"}, paths)
+
+	paths, ok = mockAssertion.DeepContains(p, "app")
+	New(t).True(ok)
+	New(t).Equal([]string{"$.Spec.Containers[0].Name", "$.Spec.Labels[\"team\"]"}, paths)
+
+	paths, ok = mockAssertion.DeepContains(&p, deepContainer{Name: "ops"})
+	New(t).True(ok)
+	New(t).Equal([]string{"$.Owner"}, paths)
+
+	_, ok = mockAssertion.DeepContains(p, "hidden")
+	New(t).False(ok, "unexported fields are not searched")
+
+	_, ok = mockAssertion.DeepContains(map[string]any{"a": []any{1, map[string]any{"b": true}}}, true)
+	New(t).True(ok)
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	_, ok = NewWithOnFailureNoop(out).DeepContains(p, "proxy:2.2")
+	New(t).False(ok)
+	New(t).Contains(out.buf.String(), "does not contain \"proxy:2.2\" at any depth")
+	New(t).Contains(out.buf.String(), "near-misses:")
+	New(t).Contains(out.buf.String(), "$.Spec.Containers[0].Name: \"app\"")
+}
+
+func TestDeepContainsCycle(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+	}
+	n := &node{Value: 1}
+	n.Next = &node{Value: 2, Next: n}
+
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	paths, ok := mockAssertion.DeepContains(n, 2)
+	New(t).True(ok)
+	New(t).Equal([]string{"$.Next.Value"}, paths)
+
+	_, ok = mockAssertion.DeepContains(n, 3)
+	New(t).False(ok)
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EveryElement asserts that assertion holds for every element of an array
+// or slice. The assertion runs once per element against an Assertions
+// derived from a, and the failures are reported together, each with the
+// index of its element.
+//
+//	a.EveryElement(users, func(a *assert.Assertions, el any) {
+//		a.True(el.(User).Active)
+//	})
+func (a *Assertions) EveryElement(list any, assertion func(a *Assertions, el any), msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EveryElement", []any{list, assertion, msgAndArgs}, func(a *Assertions) bool {
+			return a.EveryElement(list, assertion, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if isEmpty(list) {
+		return true
+	}
+	if !a.isList(list, msgAndArgs...) {
+		return false
+	}
+
+	v := reflect.ValueOf(list)
+	var results []string
+	for i := 0; i < v.Len(); i++ {
+		el := v.Index(i).Interface()
+		failures := runRecorded(a, func(a *Assertions) {
+			assertion(a, el)
+		})
+		if len(failures) > 0 {
+			results = append(results, fmt.Sprintf("[%d] %s:\n%s", i, truncatingFormat(el), strings.Join(failures, "\n")))
+		}
+	}
+	if len(results) > 0 {
+		return a.Fail(fmt.Sprintf("%d of %d element(s) failed:\n%s", len(results), v.Len(), strings.Join(results, "\n")), msgAndArgs...)
+	}
+	return true
+}
+
+// AllMatch asserts that predicate holds for every element of an array or
+// slice, and reports the elements it does not hold for with their indices.
+//
+//	a.AllMatch(users, func(el any) bool { return el.(User).Active })
+func (a *Assertions) AllMatch(list any, predicate func(el any) bool, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("AllMatch", []any{list, predicate, msgAndArgs}, func(a *Assertions) bool {
+			return a.AllMatch(list, predicate, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if isEmpty(list) {
+		return true
+	}
+	if !a.isList(list, msgAndArgs...) {
+		return false
+	}
+
+	v := reflect.ValueOf(list)
+	var mismatches []string
+	for i := 0; i < v.Len(); i++ {
+		el := v.Index(i).Interface()
+		if !predicate(el) {
+			mismatches = append(mismatches, fmt.Sprintf("[%d]: %s", i, truncatingFormat(el)))
+		}
+	}
+	if len(mismatches) > 0 {
+		return a.Fail(fmt.Sprintf("%d of %d element(s) do not match:\n%s", len(mismatches), v.Len(), strings.Join(mismatches, "\n")), msgAndArgs...)
+	}
+	return true
+}
+
+// AnyElement asserts that predicate holds for at least one element of an
+// array or slice. An empty list has no such element.
+//
+//	a.AnyElement(users, func(el any) bool { return el.(User).Admin })
+func (a *Assertions) AnyElement(list any, predicate func(el any) bool, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("AnyElement", []any{list, predicate, msgAndArgs}, func(a *Assertions) bool {
+			return a.AnyElement(list, predicate, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if isEmpty(list) {
+		return a.Fail(fmt.Sprintf("Expected an element to match, but the list is empty: %s", truncatingFormat(list)), msgAndArgs...)
+	}
+	if !a.isList(list, msgAndArgs...) {
+		return false
+	}
+
+	v := reflect.ValueOf(list)
+	for i := 0; i < v.Len(); i++ {
+		if predicate(v.Index(i).Interface()) {
+			return true
+		}
+	}
+	return a.Fail(fmt.Sprintf("None of %d element(s) match: %s", v.Len(), truncatingFormat(list)), msgAndArgs...)
+}
+
+// NoneMatch asserts that predicate holds for no element of an array or
+// slice, and reports the first element it holds for with its index.
+//
+//	a.NoneMatch(users, func(el any) bool { return el.(User).Banned })
+func (a *Assertions) NoneMatch(list any, predicate func(el any) bool, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoneMatch", []any{list, predicate, msgAndArgs}, func(a *Assertions) bool {
+			return a.NoneMatch(list, predicate, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if isEmpty(list) {
+		return true
+	}
+	if !a.isList(list, msgAndArgs...) {
+		return false
+	}
+
+	v := reflect.ValueOf(list)
+	for i := 0; i < v.Len(); i++ {
+		el := v.Index(i).Interface()
+		if predicate(el) {
+			return a.Fail(fmt.Sprintf("Expected no element to match, but element [%d] does: %s", i, truncatingFormat(el)), msgAndArgs...)
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEveryElement(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	positive := func(a *Assertions, el any) {
+		a.Greater(el, 0)
+	}
+	New(t).True(mockAssertion.EveryElement([]int{1, 2, 3}, positive))
+	New(t).True(mockAssertion.EveryElement([]int(nil), positive))
+	New(t).False(mockAssertion.EveryElement([]int{1, -2, 3}, positive))
+	New(t).False(mockAssertion.EveryElement(map[int]int{1: 1}, positive), "map is not a list")
+
+	type user struct {
+		Name   string
+		Active bool
+	}
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).EveryElement([]user{{"alice", true}, {"bob", false}, {"carol", false}}, func(a *Assertions, el any) {
+		a.True(el.(user).Active, "inactive user")
+	}, "response"))
+	New(t).Contains(out.buf.String(), "2 of 3 element(s) failed:")
+	New(t).Contains(out.buf.String(), "[1] assert.user{Name:\"bob\", Active:false}:")
+	New(t).Contains(out.buf.String(), "[2] assert.user{Name:\"carol\", Active:false}:")
+	New(t).Contains(out.buf.String(), "inactive user")
+	New(t).Contains(out.buf.String(), "response")
+	New(t).NotContains(out.buf.String(), "[0]")
+}
+
+func TestAllMatch(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	even := func(el any) bool { return el.(int)%2 == 0 }
+	New(t).True(mockAssertion.AllMatch([]int{2, 4}, even))
+	New(t).True(mockAssertion.AllMatch([]int{}, even))
+	New(t).False(mockAssertion.AllMatch([]int{2, 3}, even))
+	New(t).False(mockAssertion.AllMatch("abc", even), "string is not a list")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).AllMatch([...]int{1, 2, 3}, even))
+	New(t).Contains(out.buf.String(), "2 of 3 element(s) do not match:")
+	New(t).Contains(out.buf.String(), "[0]: 1")
+	New(t).Contains(out.buf.String(), "[2]: 3")
+}
+
+func TestAnyElement(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	even := func(el any) bool { return el.(int)%2 == 0 }
+	New(t).True(mockAssertion.AnyElement([]int{1, 2, 3}, even))
+	New(t).False(mockAssertion.AnyElement([]int{1, 3}, even))
+	New(t).False(mockAssertion.AnyElement([]int{}, even))
+	New(t).False(mockAssertion.AnyElement(42, even), "int is not a list")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).AnyElement([]int{1, 3}, even))
+	New(t).Contains(out.buf.String(), "None of 2 element(s) match: []int{1, 3}")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).AnyElement([]int(nil), even))
+	New(t).Contains(out.buf.String(), "Expected an element to match, but the list is empty: []int(nil)")
+}
+
+func TestNoneMatch(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	even := func(el any) bool { return el.(int)%2 == 0 }
+	New(t).True(mockAssertion.NoneMatch([]int{1, 3}, even))
+	New(t).True(mockAssertion.NoneMatch([]int(nil), even))
+	New(t).False(mockAssertion.NoneMatch([]int{1, 2}, even))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NoneMatch([]int{1, 2, 4}, even))
+	New(t).Contains(out.buf.String(), "Expected no element to match, but element [1] does: 2")
+	New(t).NotContains(out.buf.String(), "[2]")
+}
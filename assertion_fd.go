@@ -0,0 +1,76 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NoFDLeak asserts that running f does not leave file descriptors open, by
+// comparing the descriptors open in the current process before and after f
+// runs. The leaked descriptors are listed with their targets where the
+// platform exposes them.
+//
+// Descriptors opened concurrently by other goroutines are indistinguishable
+// from leaks, so tests using NoFDLeak should not run in parallel.
+func (a *Assertions) NoFDLeak(f func(), msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoFDLeak", []any{f, msgAndArgs}, func(a *Assertions) bool {
+			return a.NoFDLeak(f, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	before, err := openFileDescriptors()
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Cannot list open file descriptors: %s", err), msgAndArgs...)
+	}
+
+	f()
+
+	after, err := openFileDescriptors()
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Cannot list open file descriptors: %s", err), msgAndArgs...)
+	}
+
+	var leaked []int
+	for fd := range after {
+		if _, ok := before[fd]; !ok {
+			leaked = append(leaked, fd)
+		}
+	}
+
+	if len(leaked) > 0 {
+		sort.Ints(leaked)
+		lines := make([]string, 0, len(leaked))
+		for _, fd := range leaked {
+			if target := after[fd]; target != "" {
+				lines = append(lines, fmt.Sprintf("%d -> %s", fd, target))
+			} else {
+				lines = append(lines, fmt.Sprintf("%d", fd))
+			}
+		}
+		return a.Fail(fmt.Sprintf("%d file descriptor(s) leaked:\n%s", len(leaked), strings.Join(lines, "\n")), msgAndArgs...)
+	}
+
+	return true
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestNoFDLeak(t *testing.T) {
+	if _, err := openFileDescriptors(); err != nil {
+		t.Skipf("cannot list file descriptors: %s", err)
+	}
+
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	path := filepath.Join(t.TempDir(), "leak")
+
+	New(t).True(mockAssertion.NoFDLeak(func() {}))
+	New(t).True(mockAssertion.NoFDLeak(func() {
+		f, err := os.Create(path)
+		New(t).NoError(err)
+		New(t).NoError(f.Close())
+	}))
+
+	var leaked *os.File
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NoFDLeak(func() {
+		var err error
+		leaked, err = os.Open(path)
+		New(t).NoError(err)
+	}))
+	New(t).NoError(leaked.Close())
+	New(t).Contains(out.buf.String(), "1 file descriptor(s) leaked")
+	if runtime.GOOS == "linux" {
+		New(t).Contains(out.buf.String(), "-> "+path)
+	}
+}
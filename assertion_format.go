@@ -0,0 +1,920 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by gen.go; DO NOT EDIT.
+
+package assert
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Afterf is like After, with the message given as a format string.
+func (a *Assertions) Afterf(t1, t2 time.Time, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.After(t1, t2, append([]any{msg}, args...)...)
+}
+
+// AllExportedFieldsNotZerof is like AllExportedFieldsNotZero, with the message given as a format string.
+func (a *Assertions) AllExportedFieldsNotZerof(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.AllExportedFieldsNotZero(object, append([]any{msg}, args...)...)
+}
+
+// AllFieldsTaggedf is like AllFieldsTagged, with the message given as a format string.
+func (a *Assertions) AllFieldsTaggedf(object any, key string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.AllFieldsTagged(object, key, append([]any{msg}, args...)...)
+}
+
+// AllMatchf is like AllMatch, with the message given as a format string.
+func (a *Assertions) AllMatchf(list any, predicate func(el any) bool, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.AllMatch(list, predicate, append([]any{msg}, args...)...)
+}
+
+// AnyElementf is like AnyElement, with the message given as a format string.
+func (a *Assertions) AnyElementf(list any, predicate func(el any) bool, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.AnyElement(list, predicate, append([]any{msg}, args...)...)
+}
+
+// Beforef is like Before, with the message given as a format string.
+func (a *Assertions) Beforef(t1, t2 time.Time, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Before(t1, t2, append([]any{msg}, args...)...)
+}
+
+// Blankf is like Blank, with the message given as a format string.
+func (a *Assertions) Blankf(s string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Blank(s, append([]any{msg}, args...)...)
+}
+
+// Concurrentlyf is like Concurrently, with the message given as a format string.
+func (a *Assertions) Concurrentlyf(n int, body func(i int, a *Assertions), msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Concurrently(n, body, append([]any{msg}, args...)...)
+}
+
+// Conditionf is like Condition, with the message given as a format string.
+func (a *Assertions) Conditionf(comp Comparison, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Condition(comp, append([]any{msg}, args...)...)
+}
+
+// Containsf is like Contains, with the message given as a format string.
+func (a *Assertions) Containsf(s, contains any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Contains(s, contains, append([]any{msg}, args...)...)
+}
+
+// DirExistsf is like DirExists, with the message given as a format string.
+func (a *Assertions) DirExistsf(path string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.DirExists(path, append([]any{msg}, args...)...)
+}
+
+// ElementsMatchf is like ElementsMatch, with the message given as a format string.
+func (a *Assertions) ElementsMatchf(listA, listB any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ElementsMatch(listA, listB, append([]any{msg}, args...)...)
+}
+
+// Emptyf is like Empty, with the message given as a format string.
+func (a *Assertions) Emptyf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Empty(object, append([]any{msg}, args...)...)
+}
+
+// Equalf is like Equal, with the message given as a format string.
+func (a *Assertions) Equalf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Equal(expected, actual, append([]any{msg}, args...)...)
+}
+
+// EqualErrorf is like EqualError, with the message given as a format string.
+func (a *Assertions) EqualErrorf(theError error, errString string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EqualError(theError, errString, append([]any{msg}, args...)...)
+}
+
+// EqualIgnoringLineEndingsf is like EqualIgnoringLineEndings, with the message given as a format string.
+func (a *Assertions) EqualIgnoringLineEndingsf(expected, actual string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EqualIgnoringLineEndings(expected, actual, append([]any{msg}, args...)...)
+}
+
+// EqualSortedByf is like EqualSortedBy, with the message given as a format string.
+func (a *Assertions) EqualSortedByf(expected, actual any, key func(el any) any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EqualSortedBy(expected, actual, key, append([]any{msg}, args...)...)
+}
+
+// EqualValuesf is like EqualValues, with the message given as a format string.
+func (a *Assertions) EqualValuesf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EqualValues(expected, actual, append([]any{msg}, args...)...)
+}
+
+// ErrorAsf is like ErrorAs, with the message given as a format string.
+func (a *Assertions) ErrorAsf(err error, target any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ErrorAs(err, target, append([]any{msg}, args...)...)
+}
+
+// ErrorContainsf is like ErrorContains, with the message given as a format string.
+func (a *Assertions) ErrorContainsf(theError error, contains string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ErrorContains(theError, contains, append([]any{msg}, args...)...)
+}
+
+// ErrorIsf is like ErrorIs, with the message given as a format string.
+func (a *Assertions) ErrorIsf(err, target error, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ErrorIs(err, target, append([]any{msg}, args...)...)
+}
+
+// ErrorRegexpf is like ErrorRegexp, with the message given as a format string.
+func (a *Assertions) ErrorRegexpf(theError error, rx any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ErrorRegexp(theError, rx, append([]any{msg}, args...)...)
+}
+
+// Eventuallyf is like Eventually, with the message given as a format string.
+func (a *Assertions) Eventuallyf(condition func() bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Eventually(condition, waitFor, tick, append([]any{msg}, args...)...)
+}
+
+// EventuallyContextf is like EventuallyContext, with the message given as a format string.
+func (a *Assertions) EventuallyContextf(condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EventuallyContext(condition, waitFor, tick, append([]any{msg}, args...)...)
+}
+
+// EventuallyIncreasingf is like EventuallyIncreasing, with the message given as a format string.
+func (a *Assertions) EventuallyIncreasingf(getter func() float64, samples int, interval time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EventuallyIncreasing(getter, samples, interval, append([]any{msg}, args...)...)
+}
+
+// EventuallyNonDecreasingf is like EventuallyNonDecreasing, with the message given as a format string.
+func (a *Assertions) EventuallyNonDecreasingf(getter func() float64, samples int, interval time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EventuallyNonDecreasing(getter, samples, interval, append([]any{msg}, args...)...)
+}
+
+// EveryElementf is like EveryElement, with the message given as a format string.
+func (a *Assertions) EveryElementf(list any, assertion func(a *Assertions, el any), msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.EveryElement(list, assertion, append([]any{msg}, args...)...)
+}
+
+// Exactlyf is like Exactly, with the message given as a format string.
+func (a *Assertions) Exactlyf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Exactly(expected, actual, append([]any{msg}, args...)...)
+}
+
+// ExactlySameTypef is like ExactlySameType, with the message given as a format string.
+func (a *Assertions) ExactlySameTypef(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ExactlySameType(expected, actual, append([]any{msg}, args...)...)
+}
+
+// Failf is like Fail, with the message given as a format string.
+func (a *Assertions) Failf(failureMessage string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Fail(failureMessage, append([]any{msg}, args...)...)
+}
+
+// FailNowf is like FailNow, with the message given as a format string.
+func (a *Assertions) FailNowf(failureMessage string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.FailNow(failureMessage, append([]any{msg}, args...)...)
+}
+
+// Falsef is like False, with the message given as a format string.
+func (a *Assertions) Falsef(value bool, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.False(value, append([]any{msg}, args...)...)
+}
+
+// FieldEqualf is like FieldEqual, with the message given as a format string.
+func (a *Assertions) FieldEqualf(object any, path string, expected any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.FieldEqual(object, path, expected, append([]any{msg}, args...)...)
+}
+
+// FieldsNotZerof is like FieldsNotZero, with the message given as a format string.
+func (a *Assertions) FieldsNotZerof(object any, paths []string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.FieldsNotZero(object, paths, append([]any{msg}, args...)...)
+}
+
+// FileExistsf is like FileExists, with the message given as a format string.
+func (a *Assertions) FileExistsf(path string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.FileExists(path, append([]any{msg}, args...)...)
+}
+
+// FinallyNoErrorf is like FinallyNoError, with the message given as a format string.
+func (a *Assertions) FinallyNoErrorf(f func() error, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.FinallyNoError(f, append([]any{msg}, args...)...)
+}
+
+// FinallyNotNilf is like FinallyNotNil, with the message given as a format string.
+func (a *Assertions) FinallyNotNilf(f func() any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.FinallyNotNil(f, append([]any{msg}, args...)...)
+}
+
+// GobRoundTripsf is like GobRoundTrips, with the message given as a format string.
+func (a *Assertions) GobRoundTripsf(value any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.GobRoundTrips(value, append([]any{msg}, args...)...)
+}
+
+// Greaterf is like Greater, with the message given as a format string.
+func (a *Assertions) Greaterf(e1 any, e2 any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Greater(e1, e2, append([]any{msg}, args...)...)
+}
+
+// GreaterOrEqualf is like GreaterOrEqual, with the message given as a format string.
+func (a *Assertions) GreaterOrEqualf(e1 any, e2 any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.GreaterOrEqual(e1, e2, append([]any{msg}, args...)...)
+}
+
+// HasStructTagf is like HasStructTag, with the message given as a format string.
+func (a *Assertions) HasStructTagf(object any, fieldName, key, value string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.HasStructTag(object, fieldName, key, value, append([]any{msg}, args...)...)
+}
+
+// HistogramMatchesf is like HistogramMatches, with the message given as a format string.
+func (a *Assertions) HistogramMatchesf(samples any, buckets, expectedCounts []float64, tolerance float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.HistogramMatches(samples, buckets, expectedCounts, tolerance, append([]any{msg}, args...)...)
+}
+
+// Implementsf is like Implements, with the message given as a format string.
+func (a *Assertions) Implementsf(interfaceObject any, object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Implements(interfaceObject, object, append([]any{msg}, args...)...)
+}
+
+// InDeltaf is like InDelta, with the message given as a format string.
+func (a *Assertions) InDeltaf(expected, actual any, delta float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InDelta(expected, actual, delta, append([]any{msg}, args...)...)
+}
+
+// InDelta2Df is like InDelta2D, with the message given as a format string.
+func (a *Assertions) InDelta2Df(expected, actual [][]float64, delta float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InDelta2D(expected, actual, delta, append([]any{msg}, args...)...)
+}
+
+// InDeltaMapValuesf is like InDeltaMapValues, with the message given as a format string.
+func (a *Assertions) InDeltaMapValuesf(expected, actual any, delta float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InDeltaMapValues(expected, actual, delta, append([]any{msg}, args...)...)
+}
+
+// InDeltaSlicef is like InDeltaSlice, with the message given as a format string.
+func (a *Assertions) InDeltaSlicef(expected, actual any, delta float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InDeltaSlice(expected, actual, delta, append([]any{msg}, args...)...)
+}
+
+// InEpsilonf is like InEpsilon, with the message given as a format string.
+func (a *Assertions) InEpsilonf(expected, actual any, epsilon float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InEpsilon(expected, actual, epsilon, append([]any{msg}, args...)...)
+}
+
+// InEpsilonSlicef is like InEpsilonSlice, with the message given as a format string.
+func (a *Assertions) InEpsilonSlicef(expected, actual any, epsilon float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InEpsilonSlice(expected, actual, epsilon, append([]any{msg}, args...)...)
+}
+
+// InOpenRangef is like InOpenRange, with the message given as a format string.
+func (a *Assertions) InOpenRangef(value, min, max any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InOpenRange(value, min, max, append([]any{msg}, args...)...)
+}
+
+// InRangef is like InRange, with the message given as a format string.
+func (a *Assertions) InRangef(value, min, max any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.InRange(value, min, max, append([]any{msg}, args...)...)
+}
+
+// IsDecreasingf is like IsDecreasing, with the message given as a format string.
+func (a *Assertions) IsDecreasingf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.IsDecreasing(object, append([]any{msg}, args...)...)
+}
+
+// IsIncreasingf is like IsIncreasing, with the message given as a format string.
+func (a *Assertions) IsIncreasingf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.IsIncreasing(object, append([]any{msg}, args...)...)
+}
+
+// IsKindf is like IsKind, with the message given as a format string.
+func (a *Assertions) IsKindf(expectedKind reflect.Kind, object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.IsKind(expectedKind, object, append([]any{msg}, args...)...)
+}
+
+// IsNonDecreasingf is like IsNonDecreasing, with the message given as a format string.
+func (a *Assertions) IsNonDecreasingf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.IsNonDecreasing(object, append([]any{msg}, args...)...)
+}
+
+// IsNonIncreasingf is like IsNonIncreasing, with the message given as a format string.
+func (a *Assertions) IsNonIncreasingf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.IsNonIncreasing(object, append([]any{msg}, args...)...)
+}
+
+// IsTypef is like IsType, with the message given as a format string.
+func (a *Assertions) IsTypef(expectedType any, object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.IsType(expectedType, object, append([]any{msg}, args...)...)
+}
+
+// JSONEqf is like JSONEq, with the message given as a format string.
+func (a *Assertions) JSONEqf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.JSONEq(expected, actual, append([]any{msg}, args...)...)
+}
+
+// JSONLinesEqf is like JSONLinesEq, with the message given as a format string.
+func (a *Assertions) JSONLinesEqf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.JSONLinesEq(expected, actual, append([]any{msg}, args...)...)
+}
+
+// JSONRoundTripsf is like JSONRoundTrips, with the message given as a format string.
+func (a *Assertions) JSONRoundTripsf(value any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.JSONRoundTrips(value, append([]any{msg}, args...)...)
+}
+
+// Lenf is like Len, with the message given as a format string.
+func (a *Assertions) Lenf(object any, length int, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Len(object, length, append([]any{msg}, args...)...)
+}
+
+// LenBetweenf is like LenBetween, with the message given as a format string.
+func (a *Assertions) LenBetweenf(object any, min, max int, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.LenBetween(object, min, max, append([]any{msg}, args...)...)
+}
+
+// LenGreaterf is like LenGreater, with the message given as a format string.
+func (a *Assertions) LenGreaterf(object any, n int, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.LenGreater(object, n, append([]any{msg}, args...)...)
+}
+
+// LenLessf is like LenLess, with the message given as a format string.
+func (a *Assertions) LenLessf(object any, n int, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.LenLess(object, n, append([]any{msg}, args...)...)
+}
+
+// Lessf is like Less, with the message given as a format string.
+func (a *Assertions) Lessf(e1 any, e2 any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Less(e1, e2, append([]any{msg}, args...)...)
+}
+
+// LessOrEqualf is like LessOrEqual, with the message given as a format string.
+func (a *Assertions) LessOrEqualf(e1 any, e2 any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.LessOrEqual(e1, e2, append([]any{msg}, args...)...)
+}
+
+// MatchedByf is like MatchedBy, with the message given as a format string.
+func (a *Assertions) MatchedByf(actual any, matcher Matcher, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.MatchedBy(actual, matcher, append([]any{msg}, args...)...)
+}
+
+// MeanInDeltaf is like MeanInDelta, with the message given as a format string.
+func (a *Assertions) MeanInDeltaf(samples any, expected, delta float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.MeanInDelta(samples, expected, delta, append([]any{msg}, args...)...)
+}
+
+// MutexUnlockedWithinf is like MutexUnlockedWithin, with the message given as a format string.
+func (a *Assertions) MutexUnlockedWithinf(m sync.Locker, timeout time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.MutexUnlockedWithin(m, timeout, append([]any{msg}, args...)...)
+}
+
+// Negativef is like Negative, with the message given as a format string.
+func (a *Assertions) Negativef(e any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Negative(e, append([]any{msg}, args...)...)
+}
+
+// Neverf is like Never, with the message given as a format string.
+func (a *Assertions) Neverf(condition func() bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Never(condition, waitFor, tick, append([]any{msg}, args...)...)
+}
+
+// NeverContextf is like NeverContext, with the message given as a format string.
+func (a *Assertions) NeverContextf(condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NeverContext(condition, waitFor, tick, append([]any{msg}, args...)...)
+}
+
+// Nilf is like Nil, with the message given as a format string.
+func (a *Assertions) Nilf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Nil(object, append([]any{msg}, args...)...)
+}
+
+// NoDirExistsf is like NoDirExists, with the message given as a format string.
+func (a *Assertions) NoDirExistsf(path string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NoDirExists(path, append([]any{msg}, args...)...)
+}
+
+// NoErrorf is like NoError, with the message given as a format string.
+func (a *Assertions) NoErrorf(err error, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NoError(err, append([]any{msg}, args...)...)
+}
+
+// NoErrorGroupf is like NoErrorGroup, with the message given as a format string.
+func (a *Assertions) NoErrorGroupf(g ErrorGroup, timeout time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NoErrorGroup(g, timeout, append([]any{msg}, args...)...)
+}
+
+// NoFDLeakf is like NoFDLeak, with the message given as a format string.
+func (a *Assertions) NoFDLeakf(f func(), msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NoFDLeak(f, append([]any{msg}, args...)...)
+}
+
+// NoFileExistsf is like NoFileExists, with the message given as a format string.
+func (a *Assertions) NoFileExistsf(path string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NoFileExists(path, append([]any{msg}, args...)...)
+}
+
+// NoneMatchf is like NoneMatch, with the message given as a format string.
+func (a *Assertions) NoneMatchf(list any, predicate func(el any) bool, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NoneMatch(list, predicate, append([]any{msg}, args...)...)
+}
+
+// NotBlankf is like NotBlank, with the message given as a format string.
+func (a *Assertions) NotBlankf(s string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotBlank(s, append([]any{msg}, args...)...)
+}
+
+// NotContainsf is like NotContains, with the message given as a format string.
+func (a *Assertions) NotContainsf(s, contains any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotContains(s, contains, append([]any{msg}, args...)...)
+}
+
+// NotEmptyf is like NotEmpty, with the message given as a format string.
+func (a *Assertions) NotEmptyf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotEmpty(object, append([]any{msg}, args...)...)
+}
+
+// NotEqualf is like NotEqual, with the message given as a format string.
+func (a *Assertions) NotEqualf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotEqual(expected, actual, append([]any{msg}, args...)...)
+}
+
+// NotEqualValuesf is like NotEqualValues, with the message given as a format string.
+func (a *Assertions) NotEqualValuesf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotEqualValues(expected, actual, append([]any{msg}, args...)...)
+}
+
+// NotErrorIsf is like NotErrorIs, with the message given as a format string.
+func (a *Assertions) NotErrorIsf(err, target error, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotErrorIs(err, target, append([]any{msg}, args...)...)
+}
+
+// NotImplementsf is like NotImplements, with the message given as a format string.
+func (a *Assertions) NotImplementsf(interfaceObject any, object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotImplements(interfaceObject, object, append([]any{msg}, args...)...)
+}
+
+// NotLenf is like NotLen, with the message given as a format string.
+func (a *Assertions) NotLenf(object any, length int, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotLen(object, length, append([]any{msg}, args...)...)
+}
+
+// NotNilf is like NotNil, with the message given as a format string.
+func (a *Assertions) NotNilf(object any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotNil(object, append([]any{msg}, args...)...)
+}
+
+// NotPanicsf is like NotPanics, with the message given as a format string.
+func (a *Assertions) NotPanicsf(f PanicTestFunc, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotPanics(f, append([]any{msg}, args...)...)
+}
+
+// NotRegexpf is like NotRegexp, with the message given as a format string.
+func (a *Assertions) NotRegexpf(rx any, str any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotRegexp(rx, str, append([]any{msg}, args...)...)
+}
+
+// NotSamef is like NotSame, with the message given as a format string.
+func (a *Assertions) NotSamef(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotSame(expected, actual, append([]any{msg}, args...)...)
+}
+
+// NotSubsetf is like NotSubset, with the message given as a format string.
+func (a *Assertions) NotSubsetf(list, subset any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotSubset(list, subset, append([]any{msg}, args...)...)
+}
+
+// NotZerof is like NotZero, with the message given as a format string.
+func (a *Assertions) NotZerof(i any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.NotZero(i, append([]any{msg}, args...)...)
+}
+
+// Panicsf is like Panics, with the message given as a format string.
+func (a *Assertions) Panicsf(f PanicTestFunc, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Panics(f, append([]any{msg}, args...)...)
+}
+
+// PanicsWithErrorf is like PanicsWithError, with the message given as a format string.
+func (a *Assertions) PanicsWithErrorf(errString string, f PanicTestFunc, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.PanicsWithError(errString, f, append([]any{msg}, args...)...)
+}
+
+// PanicsWithValuef is like PanicsWithValue, with the message given as a format string.
+func (a *Assertions) PanicsWithValuef(expected any, f PanicTestFunc, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.PanicsWithValue(expected, f, append([]any{msg}, args...)...)
+}
+
+// PercentileLEf is like PercentileLE, with the message given as a format string.
+func (a *Assertions) PercentileLEf(samples any, p, threshold float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.PercentileLE(samples, p, threshold, append([]any{msg}, args...)...)
+}
+
+// Positivef is like Positive, with the message given as a format string.
+func (a *Assertions) Positivef(e any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Positive(e, append([]any{msg}, args...)...)
+}
+
+// PrintsToStderrf is like PrintsToStderr, with the message given as a format string.
+func (a *Assertions) PrintsToStderrf(f func(), expected string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.PrintsToStderr(f, expected, append([]any{msg}, args...)...)
+}
+
+// PrintsToStdoutf is like PrintsToStdout, with the message given as a format string.
+func (a *Assertions) PrintsToStdoutf(f func(), expected string, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.PrintsToStdout(f, expected, append([]any{msg}, args...)...)
+}
+
+// Regexpf is like Regexp, with the message given as a format string.
+func (a *Assertions) Regexpf(rx any, str any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Regexp(rx, str, append([]any{msg}, args...)...)
+}
+
+// RoundTripsf is like RoundTrips, with the message given as a format string.
+func (a *Assertions) RoundTripsf(value any, marshal MarshalFunc, unmarshal UnmarshalFunc, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.RoundTrips(value, marshal, unmarshal, append([]any{msg}, args...)...)
+}
+
+// Samef is like Same, with the message given as a format string.
+func (a *Assertions) Samef(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Same(expected, actual, append([]any{msg}, args...)...)
+}
+
+// StdDevLEf is like StdDevLE, with the message given as a format string.
+func (a *Assertions) StdDevLEf(samples any, threshold float64, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.StdDevLE(samples, threshold, append([]any{msg}, args...)...)
+}
+
+// Subsetf is like Subset, with the message given as a format string.
+func (a *Assertions) Subsetf(list, subset any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Subset(list, subset, append([]any{msg}, args...)...)
+}
+
+// Truef is like True, with the message given as a format string.
+func (a *Assertions) Truef(value bool, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.True(value, append([]any{msg}, args...)...)
+}
+
+// WaitGroupDoneWithinf is like WaitGroupDoneWithin, with the message given as a format string.
+func (a *Assertions) WaitGroupDoneWithinf(wg *sync.WaitGroup, timeout time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.WaitGroupDoneWithin(wg, timeout, append([]any{msg}, args...)...)
+}
+
+// WithinDurationf is like WithinDuration, with the message given as a format string.
+func (a *Assertions) WithinDurationf(expected, actual time.Time, delta time.Duration, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.WithinDuration(expected, actual, delta, append([]any{msg}, args...)...)
+}
+
+// WithinTimeRangef is like WithinTimeRange, with the message given as a format string.
+func (a *Assertions) WithinTimeRangef(actual, start, end time.Time, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.WithinTimeRange(actual, start, end, append([]any{msg}, args...)...)
+}
+
+// YAMLEqf is like YAMLEq, with the message given as a format string.
+func (a *Assertions) YAMLEqf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.YAMLEq(expected, actual, append([]any{msg}, args...)...)
+}
+
+// Zerof is like Zero, with the message given as a format string.
+func (a *Assertions) Zerof(i any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Zero(i, append([]any{msg}, args...)...)
+}
+
+// ZipEqualf is like ZipEqual, with the message given as a format string.
+func (a *Assertions) ZipEqualf(expected, actual any, msg string, args ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ZipEqual(expected, actual, append([]any{msg}, args...)...)
+}
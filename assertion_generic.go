@@ -0,0 +1,536 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Go does not allow type parameters on methods, so generic assertions are
+// package level functions that take the Assertions to report through as
+// their first argument.
+
+// typeOf returns the reflect.Type of T, which also works for interface types.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// exactType returns object converted to T if its dynamic type is exactly T.
+func exactType[T any](object any) (T, bool) {
+	if reflect.TypeOf(object) != typeOf[T]() {
+		var zero T
+		return zero, false
+	}
+	return object.(T), true
+}
+
+// IsTypeOf asserts that the dynamic type of object is exactly T and returns
+// object converted to T on success. Since a dynamic type is never an
+// interface type, use ImplementsT to check that object implements one.
+//
+//	user, ok := assert.IsTypeOf[*User](a, v)
+func IsTypeOf[T any](a *Assertions, object any, msgAndArgs ...any) (T, bool) {
+	if disabled {
+		v, _ := object.(T)
+		return v, true
+	}
+	if a.interceptors != nil {
+		var v T
+		ok := a.intercept("IsTypeOf", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			var ok bool
+			v, ok = IsTypeOf[T](a, object, msgAndArgs...)
+			return ok
+		})
+		return v, ok
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	v, ok := exactType[T](object)
+	if !ok {
+		return v, a.Fail(fmt.Sprintf("Object expected to be of type %v, but was %v", typeOf[T](), reflect.TypeOf(object)), msgAndArgs...)
+	}
+
+	return v, true
+}
+
+// AsType is like IsTypeOf, but stops the test immediately on mismatch and
+// returns only the converted value, which suits chained setup code.
+//
+//	user := assert.AsType[*User](a, v)
+func AsType[T any](a *Assertions, object any, msgAndArgs ...any) T {
+	if disabled {
+		v, _ := object.(T)
+		return v
+	}
+	if a.interceptors != nil {
+		var v T
+		a.intercept("AsType", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			v = AsType[T](a, object, msgAndArgs...)
+			_, ok := exactType[T](object)
+			return ok
+		})
+		return v
+	}
+	v, ok := exactType[T](object)
+	if ok {
+		return v
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	a.FailNow(fmt.Sprintf("Object expected to be of type %v, but was %v", typeOf[T](), reflect.TypeOf(object)), msgAndArgs...)
+	return v
+}
+
+// ImplementsT asserts that object implements the interface I, without the
+// (*I)(nil) argument that Implements needs.
+//
+//	assert.ImplementsT[io.Reader](a, body)
+func ImplementsT[I any](a *Assertions, object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ImplementsT", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return ImplementsT[I](a, object, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if typeOf[I]().Kind() != reflect.Interface {
+		return a.Fail(fmt.Sprintf("%v is not an interface type", typeOf[I]()), msgAndArgs...)
+	}
+	return a.Implements((*I)(nil), object, msgAndArgs...)
+}
+
+// NotImplementsT asserts that object does not implement the interface I.
+func NotImplementsT[I any](a *Assertions, object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotImplementsT", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return NotImplementsT[I](a, object, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if typeOf[I]().Kind() != reflect.Interface {
+		return a.Fail(fmt.Sprintf("%v is not an interface type", typeOf[I]()), msgAndArgs...)
+	}
+	return a.NotImplements((*I)(nil), object, msgAndArgs...)
+}
+
+// PanicsWithType asserts that the code inside the specified PanicTestFunc
+// panics with a value assignable to T, and returns the recovered value for
+// further inspection.
+//
+//	perr, ok := assert.PanicsWithType[*ParseError](a, func() { MustParse("") })
+func PanicsWithType[T any](a *Assertions, f PanicTestFunc, msgAndArgs ...any) (T, bool) {
+	if disabled {
+		var zero T
+		return zero, true
+	}
+	if a.interceptors != nil {
+		var v T
+		ok := a.intercept("PanicsWithType", []any{f, msgAndArgs}, func(a *Assertions) bool {
+			var ok bool
+			v, ok = PanicsWithType[T](a, f, msgAndArgs...)
+			return ok
+		})
+		return v, ok
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	funcDidPanic, panicValue, panickedStack := didPanic(f)
+	if !funcDidPanic {
+		var zero T
+		return zero, a.Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
+	}
+
+	v, ok := panicValue.(T)
+	if !ok {
+		return v, a.Fail(fmt.Sprintf("func %#v should panic with value of type:\t%v\n\tPanic value:\t%#v (%T)\n\tPanic stack:\t%s", f, typeOf[T](), panicValue, panicValue, panickedStack), msgAndArgs...)
+	}
+
+	return v, true
+}
+
+// Match asserts that predicate holds for value. The description states the
+// intent of the predicate and is reported along with the value on failure.
+//
+//	assert.Match(a, order, func(o Order) bool { return o.Total > 0 }, "is a valid order")
+func Match[T any](a *Assertions, value T, predicate func(v T) bool, description string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Match", []any{value, predicate, description, msgAndArgs}, func(a *Assertions) bool {
+			return Match(a, value, predicate, description, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !predicate(value) {
+		return a.Fail(fmt.Sprintf("Expected value that %s, but got: %s", description, truncatingFormat(value)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// EqualFunc asserts that eq reports expected and actual as equal. Use it
+// when reflect.DeepEqual semantics do not fit the type; the failure still
+// shows the usual diff of the two values.
+//
+//	assert.EqualFunc(a, want, got, func(x, y *big.Int) bool { return x.Cmp(y) == 0 })
+func EqualFunc[T any](a *Assertions, expected, actual T, eq func(x, y T) bool, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EqualFunc", []any{expected, actual, eq, msgAndArgs}, func(a *Assertions) bool {
+			return EqualFunc(a, expected, actual, eq, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !eq(expected, actual) {
+		if a.quiet {
+			return false
+		}
+		diff := a.diff(expected, actual)
+		failing := a.withValues(expected, actual)
+		e, v := formatUnequalValues(expected, actual)
+		return failing.Fail(fmt.Sprintf("Not equal according to the equality func: \n"+
+			"expected: %s\n"+
+			"actual  : %s%s", e, v, diff), msgAndArgs...)
+	}
+
+	return true
+}
+
+// EqualT asserts that two comparable values are equal. Unlike Equal, the
+// values must have the same type at compile time, e.g. int vs uint fails
+// to compile, and they are compared with == instead of reflection.
+//
+//	assert.EqualT(a, 42, answer())
+func EqualT[T comparable](a *Assertions, expected, actual T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EqualT", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return EqualT(a, expected, actual, msgAndArgs...)
+		})
+	}
+	if expected == actual {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.quiet {
+		return false
+	}
+
+	diff := a.diff(expected, actual)
+	e, v := formatUnequalValues(expected, actual)
+	return a.withValues(expected, actual).Fail(fmt.Sprintf("Not equal: \n"+
+		"expected: %s\n"+
+		"actual  : %s%s", e, v, diff), msgAndArgs...)
+}
+
+// NotEqualT asserts that two comparable values of the same type are not
+// equal.
+func NotEqualT[T comparable](a *Assertions, expected, actual T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotEqualT", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return NotEqualT(a, expected, actual, msgAndArgs...)
+		})
+	}
+	if expected != actual {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Fail(fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
+}
+
+// SameT asserts that two pointers of the same type reference the same
+// object.
+func SameT[T any](a *Assertions, expected, actual *T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("SameT", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return SameT(a, expected, actual, msgAndArgs...)
+		})
+	}
+	if expected == actual {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Fail(fmt.Sprintf("Not same: \n"+
+		"expected: %p %#v\n"+
+		"actual  : %p %#v", expected, expected, actual, actual), msgAndArgs...)
+}
+
+// ZeroT asserts that a comparable value is the zero value of its type.
+func ZeroT[T comparable](a *Assertions, value T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ZeroT", []any{value, msgAndArgs}, func(a *Assertions) bool {
+			return ZeroT(a, value, msgAndArgs...)
+		})
+	}
+	var zero T
+	if value == zero {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Fail(fmt.Sprintf("Should be zero, but was %v", value), msgAndArgs...)
+}
+
+// Ordered is a constraint that permits any type that supports the
+// operators < <= >= >, like cmp.Ordered of newer Go versions.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// GreaterT asserts that e1 is greater than e2. Unlike Greater, operands of
+// different or unordered types fail to compile, and no reflection is
+// involved.
+func GreaterT[T Ordered](a *Assertions, e1, e2 T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("GreaterT", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return GreaterT(a, e1, e2, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if e1 > e2 {
+		return true
+	}
+	return failOrdered(a, "\"%v\" is not greater than \"%v\"", e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqualT asserts that e1 is greater than or equal to e2.
+func GreaterOrEqualT[T Ordered](a *Assertions, e1, e2 T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("GreaterOrEqualT", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return GreaterOrEqualT(a, e1, e2, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if e1 >= e2 {
+		return true
+	}
+	return failOrdered(a, "\"%v\" is not greater than or equal to \"%v\"", e1, e2, msgAndArgs...)
+}
+
+// LessT asserts that e1 is less than e2.
+func LessT[T Ordered](a *Assertions, e1, e2 T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("LessT", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return LessT(a, e1, e2, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if e1 < e2 {
+		return true
+	}
+	return failOrdered(a, "\"%v\" is not less than \"%v\"", e1, e2, msgAndArgs...)
+}
+
+// LessOrEqualT asserts that e1 is less than or equal to e2.
+func LessOrEqualT[T Ordered](a *Assertions, e1, e2 T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("LessOrEqualT", []any{e1, e2, msgAndArgs}, func(a *Assertions) bool {
+			return LessOrEqualT(a, e1, e2, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if e1 <= e2 {
+		return true
+	}
+	return failOrdered(a, "\"%v\" is not less than or equal to \"%v\"", e1, e2, msgAndArgs...)
+}
+
+// failOrdered reports a failed ordered comparison like compareTwoValues.
+func failOrdered(a *Assertions, failMessage string, e1, e2 any, msgAndArgs ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Fail(fmt.Sprintf(failMessage, formatCompareValue(e1), formatCompareValue(e2)), msgAndArgs...)
+}
+
+// ExactlyT asserts that two values of the same static type are equal. A
+// type mismatch of the static types fails to compile; for interface types,
+// the dynamic types must match too. See ExactlySameType for the failure.
+//
+//	assert.ExactlyT(a, int64(42), counter.Load())
+func ExactlyT[T any](a *Assertions, expected, actual T, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ExactlyT", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return ExactlyT(a, expected, actual, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.ExactlySameType(expected, actual, msgAndArgs...)
+}
+
+// mustNoError fails the test immediately if err is not nil.
+// It reports whether err is nil.
+func mustNoError(a *Assertions, err error, msgAndArgs ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if err != nil {
+		return a.FailNow(fmt.Sprintf("Received unexpected error:\n%+v", err), msgAndArgs...)
+	}
+	return true
+}
+
+// interceptMustNoError runs mustNoError for the assertion named name through
+// the interceptors.
+func interceptMustNoError(a *Assertions, name string, args []any, err error, msgAndArgs ...any) {
+	a.intercept(name, args, func(a *Assertions) bool {
+		return mustNoError(a, err, msgAndArgs...)
+	})
+}
+
+// MustNoError asserts that err is nil and returns value. Unlike NoError, it
+// stops the test immediately on error, which suits setup code.
+//
+//	conn, err := net.Dial("tcp", addr)
+//	conn = assert.MustNoError(a, conn, err, "dial %s", addr)
+func MustNoError[T any](a *Assertions, value T, err error, msgAndArgs ...any) T {
+	if disabled {
+		return value
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.interceptors != nil {
+		interceptMustNoError(a, "MustNoError", []any{value, err, msgAndArgs}, err, msgAndArgs...)
+		return value
+	}
+	mustNoError(a, err, msgAndArgs...)
+	return value
+}
+
+// Must is like MustNoError for setup code that has no Assertions at hand.
+// Go only spreads multiple return values over a call that takes no other
+// arguments, so Must takes the value and the error and returns a function
+// of the TestingT, which keeps the setup on one line:
+//
+//	cfg := assert.Must(LoadConfig(path))(t)
+func Must[T any](value T, err error) func(t TestingT) T {
+	return func(t TestingT) T {
+		if disabled {
+			return value
+		}
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+		mustNoError(New(t), err)
+		return value
+	}
+}
+
+// Must2 is like MustNoError for functions that return two values and an
+// error.
+//
+//	host, port := assert.Must2(a, v1, v2, err)
+func Must2[T1, T2 any](a *Assertions, v1 T1, v2 T2, err error, msgAndArgs ...any) (T1, T2) {
+	if disabled {
+		return v1, v2
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.interceptors != nil {
+		interceptMustNoError(a, "Must2", []any{v1, v2, err, msgAndArgs}, err, msgAndArgs...)
+		return v1, v2
+	}
+	mustNoError(a, err, msgAndArgs...)
+	return v1, v2
+}
+
+// Must3 is like MustNoError for functions that return three values and an
+// error.
+func Must3[T1, T2, T3 any](a *Assertions, v1 T1, v2 T2, v3 T3, err error, msgAndArgs ...any) (T1, T2, T3) {
+	if disabled {
+		return v1, v2, v3
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.interceptors != nil {
+		interceptMustNoError(a, "Must3", []any{v1, v2, v3, err, msgAndArgs}, err, msgAndArgs...)
+		return v1, v2, v3
+	}
+	mustNoError(a, err, msgAndArgs...)
+	return v1, v2, v3
+}
@@ -0,0 +1,386 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsTypeOf(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	object := new(AssertionTesterConformingObject)
+	v, ok := IsTypeOf[*AssertionTesterConformingObject](mockAssertion, object)
+	New(t).True(ok)
+	New(t).Same(object, v)
+
+	i, ok := IsTypeOf[AssertionTesterInterface](mockAssertion, object)
+	New(t).False(ok, "implementing an interface is not having it as dynamic type")
+	New(t).Nil(i)
+
+	_, ok = IsTypeOf[io.Reader](mockAssertion, &bytes.Buffer{})
+	New(t).False(ok)
+
+	n, ok := IsTypeOf[*AssertionTesterNonConformingObject](mockAssertion, object)
+	New(t).False(ok)
+	New(t).Nil(n)
+
+	s, ok := IsTypeOf[string](mockAssertion, nil)
+	New(t).False(ok)
+	New(t).Equal("", s)
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	_, ok = IsTypeOf[int64](NewWithOnFailureNoop(out), 42)
+	New(t).False(ok)
+	New(t).Contains(out.buf.String(), "Object expected to be of type int64, but was int")
+}
+
+func TestPanicsWithType(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	err, ok := PanicsWithType[error](mockAssertion, func() {
+		panic(errors.New("panic"))
+	})
+	New(t).True(ok)
+	New(t).EqualError(err, "panic")
+
+	ce, ok := PanicsWithType[*customError](mockAssertion, func() {
+		panic(&customError{})
+	})
+	New(t).True(ok)
+	New(t).NotNil(ce)
+
+	s, ok := PanicsWithType[string](mockAssertion, func() {
+		panic(42)
+	})
+	New(t).False(ok)
+	New(t).Equal("", s)
+
+	_, ok = PanicsWithType[string](mockAssertion, func() {})
+	New(t).False(ok)
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	_, ok = PanicsWithType[error](NewWithOnFailureNoop(out), func() {
+		panic("panic")
+	})
+	New(t).False(ok)
+	New(t).Contains(out.buf.String(), "should panic with value of type:\terror")
+	New(t).Contains(out.buf.String(), `"panic" (string)`)
+}
+
+func TestMatch(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	positive := func(n int) bool { return n > 0 }
+	New(t).True(Match(mockAssertion, 1, positive, "is positive"))
+	New(t).False(Match(mockAssertion, -1, positive, "is positive"))
+
+	type order struct {
+		ID    string
+		Total int
+	}
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(Match(NewWithOnFailureNoop(out), order{ID: "o-1"}, func(o order) bool {
+		return o.Total > 0
+	}, "is a valid order", "checkout"))
+	New(t).Contains(out.buf.String(), `Expected value that is a valid order, but got: assert.order{ID:"o-1", Total:0}`)
+	New(t).Contains(out.buf.String(), "checkout")
+}
+
+// fatalT is an outputT whose FailNow stops the calling goroutine, like
+// testing.T does.
+type fatalT struct {
+	outputT
+}
+
+func (t *fatalT) FailNow() {
+	runtime.Goexit()
+}
+
+func TestEqualFunc(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	sameLength := func(x, y []int) bool { return len(x) == len(y) }
+
+	New(t).True(EqualFunc(mockAssertion, []int{1, 2}, []int{3, 4}, sameLength))
+	New(t).False(EqualFunc(mockAssertion, []int{1, 2}, []int{1}, sameLength))
+	New(t).True(EqualFunc(mockAssertion, "Go", "go", strings.EqualFold))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(EqualFunc(NewWithOnFailureNoop(out), []int{1, 2}, []int{1}, sameLength, "lengths"))
+	New(t).Contains(out.buf.String(), "Not equal according to the equality func:")
+	New(t).Contains(out.buf.String(), "expected: []int{1, 2}")
+	New(t).Contains(out.buf.String(), "actual  : []int{1}")
+	New(t).Contains(out.buf.String(), "Diff:")
+	New(t).Contains(out.buf.String(), "lengths")
+}
+
+func TestMustNoError(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	New(t).Equal(42, MustNoError(mockAssertion, 42, nil))
+
+	out := &fatalT{outputT{buf: bytes.NewBuffer(nil)}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		MustNoError(New(out), "value", errors.New("connection refused"), "dial")
+		t.Error("MustNoError should stop the test")
+	}()
+	<-done
+	New(t).Contains(out.buf.String(), "Received unexpected error:\n\t            \tconnection refused")
+	New(t).Contains(out.buf.String(), "dial")
+}
+
+func TestMust(t *testing.T) {
+	parse := func(s string) (int, error) {
+		if s == "42" {
+			return 42, nil
+		}
+		return 0, errors.New("invalid syntax")
+	}
+	New(t).Equal(42, Must(parse("42"))(t))
+
+	out := &fatalT{outputT{buf: bytes.NewBuffer(nil)}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Must(parse("x"))(out)
+		t.Error("Must should stop the test")
+	}()
+	<-done
+	New(t).Contains(out.buf.String(), "invalid syntax")
+}
+
+func TestMust2(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	host, port := Must2(mockAssertion, "localhost", 8080, nil)
+	New(t).Equal("localhost", host)
+	New(t).Equal(8080, port)
+
+	v1, v2, v3 := Must3(mockAssertion, 1, "two", 3.0, nil)
+	New(t).Equal(1, v1)
+	New(t).Equal("two", v2)
+	New(t).Equal(3.0, v3)
+
+	out := &fatalT{outputT{buf: bytes.NewBuffer(nil)}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Must3(New(out), 1, 2, 3, errors.New("boom"))
+		t.Error("Must3 should stop the test")
+	}()
+	<-done
+	New(t).Contains(out.buf.String(), "boom")
+}
+
+func TestExactlyT(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(ExactlyT(mockAssertion, int64(1), int64(1)))
+	New(t).False(ExactlyT(mockAssertion, "a", "b"))
+	New(t).True(ExactlyT[any](mockAssertion, 1, 1))
+	New(t).False(ExactlyT[any](mockAssertion, 1, int64(1)))
+	New(t).True(ExactlyT[error](mockAssertion, nil, nil))
+}
+
+func TestExactlySameType(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.ExactlySameType([]int{1}, []int{1}))
+	New(t).True(mockAssertion.ExactlySameType(nil, nil))
+	New(t).False(mockAssertion.ExactlySameType(int32(1), int64(1)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).ExactlySameType(int32(1), int64(1)))
+	New(t).Contains(out.buf.String(), "type : expected int32, actual int64")
+	New(t).Contains(out.buf.String(), "value: equal after converting to int64")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).ExactlySameType(int32(1), int64(2)))
+	New(t).Contains(out.buf.String(), "type : expected int32, actual int64")
+	New(t).Contains(out.buf.String(), "value: expected int32(1), actual int64(2)")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).ExactlySameType([]int{1, 2}, []int{1, 3}))
+	New(t).Contains(out.buf.String(), "type : same ([]int)")
+	New(t).Contains(out.buf.String(), "value: expected []int{1, 2}, actual []int{1, 3}")
+	New(t).Contains(out.buf.String(), "Diff:")
+}
+
+func TestEqualT(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	type point struct{ X, Y int }
+	New(t).True(EqualT(mockAssertion, 1, 1))
+	New(t).True(EqualT(mockAssertion, point{1, 2}, point{1, 2}))
+	New(t).False(EqualT(mockAssertion, "a", "b"))
+	New(t).True(NotEqualT(mockAssertion, uint(1), 2))
+	New(t).False(NotEqualT(mockAssertion, point{}, point{}))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(EqualT(NewWithOnFailureNoop(out), point{1, 2}, point{1, 3}))
+	New(t).Contains(out.buf.String(), "expected: assert.point{X:1, Y:2}")
+	New(t).Contains(out.buf.String(), "actual  : assert.point{X:1, Y:3}")
+	New(t).Contains(out.buf.String(), "Diff:")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NotEqualT(NewWithOnFailureNoop(out), 3, 3))
+	New(t).Contains(out.buf.String(), "Should not be: 3")
+}
+
+func TestSameT(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	p, q := new(int), new(int)
+	New(t).True(SameT(mockAssertion, p, p))
+	New(t).False(SameT(mockAssertion, p, q))
+	New(t).True(SameT[int](mockAssertion, nil, nil))
+}
+
+func TestZeroT(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(ZeroT(mockAssertion, 0))
+	New(t).True(ZeroT(mockAssertion, ""))
+	New(t).True(ZeroT[*int](mockAssertion, nil))
+	New(t).False(ZeroT(mockAssertion, 0.5))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(ZeroT(NewWithOnFailureNoop(out), "x"))
+	New(t).Contains(out.buf.String(), "Should be zero, but was x")
+}
+
+func TestAsType(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	New(t).Equal("tison", AsType[string](mockAssertion, "tison"))
+	New(t).NotNil(AsType[*customError](mockAssertion, &customError{}))
+
+	out := &fatalT{outputT{buf: bytes.NewBuffer(nil)}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		AsType[int](New(out), "1", "counter")
+		t.Error("AsType should stop the test")
+	}()
+	<-done
+	New(t).Contains(out.buf.String(), "Object expected to be of type int, but was string")
+	New(t).Contains(out.buf.String(), "counter")
+}
+
+func TestImplementsT(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(ImplementsT[AssertionTesterInterface](mockAssertion, new(AssertionTesterConformingObject)))
+	New(t).False(ImplementsT[AssertionTesterInterface](mockAssertion, new(AssertionTesterNonConformingObject)))
+	New(t).True(ImplementsT[error](mockAssertion, errors.New("boom")))
+	New(t).False(ImplementsT[error](mockAssertion, nil))
+	New(t).True(NotImplementsT[error](mockAssertion, "boom"))
+	New(t).False(NotImplementsT[AssertionTesterInterface](mockAssertion, new(AssertionTesterConformingObject)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(ImplementsT[string](NewWithOnFailureNoop(out), "boom"))
+	New(t).Contains(out.buf.String(), "string is not an interface type")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(ImplementsT[error](NewWithOnFailureNoop(out), 42))
+	New(t).Contains(out.buf.String(), "int must implement error")
+}
+
+func TestOrderedT(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(GreaterT(mockAssertion, 2, 1))
+	New(t).False(GreaterT(mockAssertion, 1, 1))
+	New(t).True(GreaterOrEqualT(mockAssertion, "b", "b"))
+	New(t).False(GreaterOrEqualT(mockAssertion, "a", "b"))
+	New(t).True(LessT(mockAssertion, uint8(1), 2))
+	New(t).False(LessT(mockAssertion, 1.5, 1.5))
+	New(t).True(LessOrEqualT(mockAssertion, time.Second, time.Second))
+	New(t).False(LessOrEqualT(mockAssertion, 2, 1))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(LessT(NewWithOnFailureNoop(out), 2*time.Second, time.Second))
+	New(t).Contains(out.buf.String(), `"2s" is not less than "1s"`)
+}
+
+// lineT records where testing.T reports its first failure: the first frame
+// above Errorf of a function that is not marked as a helper.
+type lineT struct {
+	outputT
+	file string
+	line int
+}
+
+func (t *lineT) Helper() {
+	if t.helpers == nil {
+		t.helpers = make(map[string]struct{})
+	}
+	t.helpers[callerName(1)] = struct{}{}
+}
+
+func (t *lineT) Errorf(format string, args ...any) {
+	t.outputT.Errorf(format, args...)
+	if t.file != "" {
+		return
+	}
+	pc := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+	for {
+		frame, more := frames.Next()
+		if _, ok := t.helpers[frame.Function]; !ok || !more {
+			t.file, t.line = frame.File, frame.Line
+			return
+		}
+	}
+}
+
+func TestOrderedTReportsCaller(t *testing.T) {
+	for _, c := range []struct {
+		ordered func(*Assertions, int, int, ...any) bool
+		e1, e2  int
+	}{
+		{GreaterT[int], 1, 2},
+		{GreaterOrEqualT[int], 1, 2},
+		{LessT[int], 2, 1},
+		{LessOrEqualT[int], 2, 1},
+	} {
+		out := &lineT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+		_, file, line, _ := runtime.Caller(0)
+		New(t).False(c.ordered(NewWithOnFailureNoop(out), c.e1, c.e2))
+		New(t).Equal(file, out.file)
+		New(t).Equal(line+1, out.line)
+	}
+}
+
+func BenchmarkGreaterT(b *testing.B) {
+	a := New(b)
+	for i := 0; i < b.N; i++ {
+		GreaterT(a, i+1, i)
+	}
+}
+
+func BenchmarkGreater(b *testing.B) {
+	a := New(b)
+	for i := 0; i < b.N; i++ {
+		a.Greater(i+1, i)
+	}
+}
@@ -0,0 +1,445 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// JSONLinesOption configures how JSONLinesEq compares records; see
+// WithJSONLinesOptions.
+type JSONLinesOption func(*jsonLinesConfig)
+
+type jsonLinesConfig struct {
+	unordered bool
+	ignored   [][]string
+}
+
+// JSONLinesUnordered makes JSONLinesEq match records regardless of their
+// order. Duplicated records must appear the same number of times.
+func JSONLinesUnordered() JSONLinesOption {
+	return func(c *jsonLinesConfig) {
+		c.unordered = true
+	}
+}
+
+// JSONLinesIgnoreFields makes JSONLinesEq drop the given fields from every
+// record before comparing. Nested fields are addressed with dots, e.g.
+// "meta.timestamp".
+func JSONLinesIgnoreFields(fields ...string) JSONLinesOption {
+	return func(c *jsonLinesConfig) {
+		for _, field := range fields {
+			c.ignored = append(c.ignored, strings.Split(field, "."))
+		}
+	}
+}
+
+// WithJSONLinesOptions returns a new Assertions whose JSONLinesEq compares
+// records as configured by opts, in addition to the options already set.
+func (a *Assertions) WithJSONLinesOptions(opts ...JSONLinesOption) *Assertions {
+	c := *a
+	c.jsonLinesConfig.ignored = append([][]string(nil), a.jsonLinesConfig.ignored...)
+	for _, opt := range opts {
+		opt(&c.jsonLinesConfig)
+	}
+	return &c
+}
+
+// jsonLine is a decoded record of a JSON Lines document.
+type jsonLine struct {
+	number int
+	raw    string
+	value  any
+}
+
+// JSONLinesEq asserts that two newline-delimited JSON (JSON Lines) documents
+// hold equivalent records. Like for JSONEq, each document may be given as a
+// string, []byte, json.RawMessage or io.Reader. Blank lines are skipped and
+// mismatches are reported by line number. The comparison is customized with
+// WithJSONLinesOptions.
+//
+//	a.WithJSONLinesOptions(assert.JSONLinesIgnoreFields("time")).JSONLinesEq(expected, &buf)
+func (a *Assertions) JSONLinesEq(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("JSONLinesEq", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.JSONLinesEq(expected, actual, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	config := a.jsonLinesConfig
+	expectedDoc, actualDoc, err := documentTexts(expected, actual)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
+
+	expectedLines, err := parseJSONLines(expectedDoc, config.ignored)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Expected value is not valid JSON Lines.\n%s", err.Error()), msgAndArgs...)
+	}
+	actualLines, err := parseJSONLines(actualDoc, config.ignored)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Input needs to be valid JSON Lines.\n%s", err.Error()), msgAndArgs...)
+	}
+
+	var mismatches []string
+	if config.unordered {
+		mismatches = unorderedJSONLinesMismatches(expectedLines, actualLines)
+	} else {
+		mismatches = orderedJSONLinesMismatches(expectedLines, actualLines)
+	}
+	if len(mismatches) > 0 {
+		return a.Fail(fmt.Sprintf("JSON Lines not equal:\n%s", strings.Join(mismatches, "\n")), msgAndArgs...)
+	}
+
+	return true
+}
+
+func parseJSONLines(s string, ignored [][]string) ([]jsonLine, error) {
+	var lines []jsonLine
+	for i, raw := range strings.Split(s, "\n") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return nil, fmt.Errorf("line %d ('%s'): JSON parsing error: '%s'", i+1, raw, err.Error())
+		}
+		for _, path := range ignored {
+			removeJSONField(value, path)
+		}
+		lines = append(lines, jsonLine{number: i + 1, raw: raw, value: value})
+	}
+	return lines, nil
+}
+
+// removeJSONField deletes the field addressed by path from a decoded JSON
+// value, if present.
+func removeJSONField(value any, path []string) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		delete(object, path[0])
+		return
+	}
+	removeJSONField(object[path[0]], path[1:])
+}
+
+func orderedJSONLinesMismatches(expected, actual []jsonLine) []string {
+	var mismatches []string
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		switch {
+		case i >= len(actual):
+			mismatches = append(mismatches, fmt.Sprintf("record %d (expected line %d) is missing: %s", i+1, expected[i].number, expected[i].raw))
+		case i >= len(expected):
+			mismatches = append(mismatches, fmt.Sprintf("record %d (actual line %d) is unexpected: %s", i+1, actual[i].number, actual[i].raw))
+		case !ObjectsAreEqual(expected[i].value, actual[i].value):
+			mismatches = append(mismatches, fmt.Sprintf("record %d (expected line %d, actual line %d) differs:\n\texpected: %s\n\tactual  : %s",
+				i+1, expected[i].number, actual[i].number, expected[i].raw, actual[i].raw))
+		}
+	}
+	return mismatches
+}
+
+func unorderedJSONLinesMismatches(expected, actual []jsonLine) []string {
+	var mismatches []string
+	matched := make([]bool, len(actual))
+	for _, e := range expected {
+		found := false
+		for j, act := range actual {
+			if !matched[j] && ObjectsAreEqual(e.value, act.value) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			mismatches = append(mismatches, fmt.Sprintf("expected line %d has no matching record: %s", e.number, e.raw))
+		}
+	}
+	for j, act := range actual {
+		if !matched[j] {
+			mismatches = append(mismatches, fmt.Sprintf("actual line %d is unexpected: %s", act.number, act.raw))
+		}
+	}
+	return mismatches
+}
+
+// jsonPathDiff lists the differences between two decoded JSON values as
+// JSONPath expressions with the expected and actual values, e.g.
+// "$.items[2].price: 10 != 12". Object keys are visited in sorted order.
+func jsonPathDiff(path string, expected, actual any) []string {
+	switch e := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(e)+len(act))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		for k := range act {
+			if _, ok := e[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		var differences []string
+		for _, k := range keys {
+			ev, eok := e[k]
+			av, aok := act[k]
+			p := jsonPathKey(path, k)
+			switch {
+			case !aok:
+				differences = append(differences, fmt.Sprintf("%s: missing, expected %s", p, compactJSON(ev)))
+			case !eok:
+				differences = append(differences, fmt.Sprintf("%s: unexpected %s", p, compactJSON(av)))
+			default:
+				differences = append(differences, jsonPathDiff(p, ev, av)...)
+			}
+		}
+		return differences
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			break
+		}
+		var differences []string
+		for i := 0; i < len(e) || i < len(act); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(act):
+				differences = append(differences, fmt.Sprintf("%s: missing, expected %s", p, compactJSON(e[i])))
+			case i >= len(e):
+				differences = append(differences, fmt.Sprintf("%s: unexpected %s", p, compactJSON(act[i])))
+			default:
+				differences = append(differences, jsonPathDiff(p, e[i], act[i])...)
+			}
+		}
+		return differences
+	}
+
+	if ObjectsAreEqual(expected, actual) {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: %s != %s", path, compactJSON(expected), compactJSON(actual))}
+}
+
+// jsonPathKey appends an object key to a JSONPath expression, falling back
+// to bracket notation for keys that are not plain identifiers.
+func jsonPathKey(path, key string) string {
+	if key == "" {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	for i, r := range key {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return fmt.Sprintf("%s[%q]", path, key)
+		}
+	}
+	return path + "." + key
+}
+
+func compactJSON(v any) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%#v", v)
+	}
+	return string(out)
+}
+
+// WithJSONPatch returns a new Assertions whose structural assertions (JSONEq,
+// and Equal on maps) also report the difference as an RFC 6902 JSON Patch
+// that turns the expected value into the actual one. Tooling can apply the
+// patch to update fixtures.
+func (a *Assertions) WithJSONPatch() *Assertions {
+	c := *a
+	c.jsonPatch = true
+	return &c
+}
+
+// jsonPatchOp is a single RFC 6902 operation.
+type jsonPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// formatJSONPatch renders the JSON Patch between two decoded JSON values if
+// the Assertions is configured to report one.
+func (a *Assertions) formatJSONPatch(expected, actual any) string {
+	if !a.jsonPatch {
+		return ""
+	}
+	out, err := json.MarshalIndent(jsonPatch("", expected, actual), "", "  ")
+	if err != nil {
+		return ""
+	}
+	return "\n\nJSON Patch:\n" + string(out)
+}
+
+// formatMapJSONPatch renders the JSON Patch between two maps, provided both
+// can be represented as JSON.
+func (a *Assertions) formatMapJSONPatch(expected, actual any) string {
+	if !a.jsonPatch {
+		return ""
+	}
+	if expected == nil || reflect.TypeOf(expected) != reflect.TypeOf(actual) || reflect.TypeOf(expected).Kind() != reflect.Map {
+		return ""
+	}
+	e, err := decodeAsJSON(expected)
+	if err != nil {
+		return ""
+	}
+	act, err := decodeAsJSON(actual)
+	if err != nil {
+		return ""
+	}
+	return a.formatJSONPatch(e, act)
+}
+
+func decodeAsJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	err = json.Unmarshal(data, &decoded)
+	return decoded, err
+}
+
+// jsonPatch computes the operations that transform expected into actual.
+// Array elements are replaced pairwise, then appended or removed at the end;
+// removals go from the last index down so that every path stays valid.
+func jsonPatch(pointer string, expected, actual any) []jsonPatchOp {
+	switch e := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(e)+len(act))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		for k := range act {
+			if _, ok := e[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		var ops []jsonPatchOp
+		for _, k := range keys {
+			ev, eok := e[k]
+			av, aok := act[k]
+			p := pointer + "/" + jsonPointerEscaper.Replace(k)
+			switch {
+			case !aok:
+				ops = append(ops, jsonPatchOp{Op: "remove", Path: p})
+			case !eok:
+				ops = append(ops, jsonPatchOp{Op: "add", Path: p, Value: compactJSONRaw(av)})
+			default:
+				ops = append(ops, jsonPatch(p, ev, av)...)
+			}
+		}
+		return ops
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			break
+		}
+		var ops []jsonPatchOp
+		for i := 0; i < len(e) && i < len(act); i++ {
+			ops = append(ops, jsonPatch(fmt.Sprintf("%s/%d", pointer, i), e[i], act[i])...)
+		}
+		for i := len(e); i < len(act); i++ {
+			ops = append(ops, jsonPatchOp{Op: "add", Path: fmt.Sprintf("%s/%d", pointer, i), Value: compactJSONRaw(act[i])})
+		}
+		for i := len(e) - 1; i >= len(act); i-- {
+			ops = append(ops, jsonPatchOp{Op: "remove", Path: fmt.Sprintf("%s/%d", pointer, i)})
+		}
+		return ops
+	}
+
+	if ObjectsAreEqual(expected, actual) {
+		return nil
+	}
+	return []jsonPatchOp{{Op: "replace", Path: pointer, Value: compactJSONRaw(actual)}}
+}
+
+// compactJSONRaw encodes a decoded JSON value, keeping null as an explicit
+// value.
+func compactJSONRaw(v any) json.RawMessage {
+	return json.RawMessage(compactJSON(v))
+}
+
+// jsonPointerEscaper escapes a reference token as defined by RFC 6901.
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// documentText reads a document given as a string, []byte (including
+// json.RawMessage) or io.Reader.
+func documentText(v any) (string, error) {
+	switch d := v.(type) {
+	case string:
+		return d, nil
+	case []byte:
+		return string(d), nil
+	case json.RawMessage:
+		return string(d), nil
+	case io.Reader:
+		b, err := io.ReadAll(d)
+		if err != nil {
+			return "", fmt.Errorf("reading failed: %w", err)
+		}
+		return string(b), nil
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), nil
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return string(rv.Bytes()), nil
+	}
+	return "", fmt.Errorf("unsupported type %T, want string, []byte or io.Reader", v)
+}
+
+// documentTexts reads the expected and actual documents of assertions like
+// JSONEq.
+func documentTexts(expected, actual any) (string, string, error) {
+	e, err := documentText(expected)
+	if err != nil {
+		return "", "", fmt.Errorf("Expected document: %s", err)
+	}
+	x, err := documentText(actual)
+	if err != nil {
+		return "", "", fmt.Errorf("Actual document: %s", err)
+	}
+	return e, x, nil
+}
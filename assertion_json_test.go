@@ -0,0 +1,156 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestJSONLinesEq(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	expected := "{\"id\":1,\"msg\":\"a\"}\n{\"id\":2,\"msg\":\"b\"}\n"
+	New(t).True(mockAssertion.JSONLinesEq(expected, "{\"msg\":\"a\", \"id\":1}\n\n{\"id\":2,\"msg\":\"b\"}"))
+	New(t).False(mockAssertion.JSONLinesEq(expected, "{\"id\":2,\"msg\":\"b\"}\n{\"id\":1,\"msg\":\"a\"}"))
+	New(t).True(mockAssertion.WithJSONLinesOptions(JSONLinesUnordered()).JSONLinesEq(expected, "{\"id\":2,\"msg\":\"b\"}\n{\"id\":1,\"msg\":\"a\"}"))
+	New(t).False(mockAssertion.JSONLinesEq(expected, "{\"id\":1,\"msg\":\"a\"}"))
+	New(t).False(mockAssertion.WithJSONLinesOptions(JSONLinesUnordered()).JSONLinesEq(expected, "{\"id\":1,\"msg\":\"a\"}\n{\"id\":1,\"msg\":\"a\"}"))
+	New(t).False(mockAssertion.JSONLinesEq(expected, "{\"id\":1"))
+	New(t).False(mockAssertion.JSONLinesEq("{} {}", "{}"))
+
+	New(t).True(mockAssertion.WithJSONLinesOptions(JSONLinesIgnoreFields("time", "meta.pid")).JSONLinesEq(
+		"{\"id\":1,\"time\":\"t1\",\"meta\":{\"host\":\"a\",\"pid\":1}}",
+		"{\"id\":1,\"time\":\"t2\",\"meta\":{\"host\":\"a\",\"pid\":2}}"))
+	New(t).False(mockAssertion.WithJSONLinesOptions(JSONLinesIgnoreFields("time")).JSONLinesEq(
+		"{\"id\":1,\"time\":\"t1\"}",
+		"{\"id\":2,\"time\":\"t2\"}"))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONLinesEq(expected, "{\"id\":1,\"msg\":\"a\"}\n\n{\"id\":2,\"msg\":\"c\"}\n{\"id\":3}"))
+	New(t).Contains(out.buf.String(), "record 2 (expected line 2, actual line 3) differs")
+	New(t).Contains(out.buf.String(), "record 3 (actual line 4) is unexpected")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithJSONLinesOptions(JSONLinesUnordered()).JSONLinesEq(expected, "{\"id\":2,\"msg\":\"b\"}\n{\"id\":3}"))
+	New(t).Contains(out.buf.String(), "expected line 1 has no matching record")
+	New(t).Contains(out.buf.String(), "actual line 2 is unexpected")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONLinesEq(expected, "{}\n{\"id\":"))
+	New(t).Contains(out.buf.String(), "line 2 ('{\"id\":'): JSON parsing error")
+}
+
+func TestJSONEqPathDiff(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONEq(
+		`{"items": [{"price": 1}, {"price": 5}, {"price": 10}], "name": "cart", "tags": ["a"], "a-b": 1}`,
+		`{"items": [{"price": 1}, {"price": 5}, {"price": 12}], "owner": "tison", "tags": ["a", "b"], "a-b": 2}`))
+	New(t).Contains(out.buf.String(), `$["a-b"]: 1 != 2`)
+	New(t).Contains(out.buf.String(), "$.items[2].price: 10 != 12")
+	New(t).Contains(out.buf.String(), `$.name: missing, expected "cart"`)
+	New(t).Contains(out.buf.String(), `$.owner: unexpected "tison"`)
+	New(t).Contains(out.buf.String(), `$.tags[1]: unexpected "b"`)
+	New(t).NotContains(out.buf.String(), "$.items[0]")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONEq(`{"a": [1]}`, `{"a": {"b": 1}}`))
+	New(t).Contains(out.buf.String(), `$.a: [1] != {"b":1}`)
+
+	for _, test := range []struct {
+		key      string
+		expected string
+	}{
+		{"foo", "$.foo"},
+		{"foo_2", "$.foo_2"},
+		{"2foo", `$["2foo"]`},
+		{"foo bar", `$["foo bar"]`},
+		{"", `$[""]`},
+	} {
+		New(t).Equal(test.expected, jsonPathKey("$", test.key))
+	}
+}
+
+func TestWithJSONPatch(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONEq(`{"a": 1}`, `{"a": 2}`))
+	New(t).NotContains(out.buf.String(), "JSON Patch")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithJSONPatch().JSONEq(
+		`{"a": 1, "b/c": true, "list": [1, 2, 3], "gone": "x"}`,
+		`{"a": 2, "b/c": true, "list": [1], "new": null}`))
+	New(t).Contains(out.buf.String(), "JSON Patch:")
+	patch := out.buf.String()[strings.Index(out.buf.String(), "JSON Patch:")+len("JSON Patch:"):]
+	patch = patch[:strings.LastIndex(patch, "]")+1]
+	New(t).JSONEq(`[
+		{"op": "replace", "path": "/a", "value": 2},
+		{"op": "remove", "path": "/gone"},
+		{"op": "remove", "path": "/list/2"},
+		{"op": "remove", "path": "/list/1"},
+		{"op": "add", "path": "/new", "value": null}
+	]`, patch)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithJSONPatch().Equal(
+		map[string]int{"x": 1, "y": 2},
+		map[string]int{"x": 1, "y/z": 3}))
+	New(t).Contains(out.buf.String(), `"path": "/y~1z"`)
+	New(t).Contains(out.buf.String(), `"op": "remove"`)
+
+	New(t).Equal([]jsonPatchOp{{Op: "add", Path: "/0/k~0", Value: []byte(`[1]`)}},
+		jsonPatch("", []any{map[string]any{}}, []any{map[string]any{"k~": []any{float64(1)}}}))
+}
+
+func TestJSONEqDocumentTypes(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	type jsonText string
+	expected := `{"id": 1, "tags": ["a"]}`
+	const doc = `{"tags": ["a"], "id": 1}`
+	for _, document := range []func() any{
+		func() any { return doc },
+		func() any { return []byte(doc) },
+		func() any { return json.RawMessage(doc) },
+		func() any { return strings.NewReader(doc) },
+		func() any { return bytes.NewBufferString(doc) },
+		func() any { return jsonText(doc) },
+	} {
+		New(t).True(mockAssertion.JSONEq(expected, document()), "%T", document())
+		New(t).True(mockAssertion.JSONEq(document(), []byte(expected)), "%T", document())
+	}
+	New(t).True(mockAssertion.YAMLEq([]byte("id: 1"), strings.NewReader(`{"id": 1}`)))
+	New(t).False(mockAssertion.YAMLEq([]byte("id: 1"), json.RawMessage(`{"id": 2}`)))
+	New(t).True(mockAssertion.JSONLinesEq([]byte("{\"id\":1}\n{\"id\":2}"), strings.NewReader("{\"id\": 1}\n{\"id\": 2}\n")))
+	New(t).False(mockAssertion.JSONLinesEq(json.RawMessage(`{"id":1}`), bytes.NewBufferString(`{"id":2}`)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONEq(expected, 42))
+	New(t).Contains(out.buf.String(), "Actual document: unsupported type int, want string, []byte or io.Reader")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).JSONLinesEq(42, "{}"))
+	New(t).Contains(out.buf.String(), "Expected document: unsupported type int, want string, []byte or io.Reader")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).YAMLEq(iotest.ErrReader(errors.New("boom")), "id: 1"))
+	New(t).Contains(out.buf.String(), "Expected document: reading failed: boom")
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// lenPreviewSize bounds the preview of a collection in length failures, so
+// that a huge result page does not flood the output.
+const lenPreviewSize = 100
+
+// lenPreview formats object for a length failure message, truncated to
+// lenPreviewSize runes.
+func lenPreview(object any) string {
+	s := fmt.Sprintf("%v", object)
+	if utf8.RuneCountInString(s) <= lenPreviewSize {
+		return s
+	}
+	return string([]rune(s)[:lenPreviewSize]) + "<... truncated>"
+}
+
+// checkLen returns the length of object, or fails if object has a type that
+// len() does not accept.
+func (a *Assertions) checkLen(object any, msgAndArgs ...any) (int, bool) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	ok, l := getLen(object)
+	if !ok {
+		return 0, a.Fail(fmt.Sprintf("\"%s\" could not be applied builtin len()", object), msgAndArgs...)
+	}
+	return l, true
+}
+
+// LenGreater asserts that the specified object has more than n items.
+// LenGreater also fails if the object has a type that len() not accept.
+//
+//	a.LenGreater(page.Items, 0)
+func (a *Assertions) LenGreater(object any, n int, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("LenGreater", []any{object, n, msgAndArgs}, func(a *Assertions) bool {
+			return a.LenGreater(object, n, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	l, ok := a.checkLen(object, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	if l <= n {
+		return a.Fail(fmt.Sprintf("\"%s\" should have more than %d item(s), but has %d", lenPreview(object), n, l), msgAndArgs...)
+	}
+	return true
+}
+
+// LenLess asserts that the specified object has fewer than n items.
+// LenLess also fails if the object has a type that len() not accept.
+//
+//	a.LenLess(page.Items, 51)
+func (a *Assertions) LenLess(object any, n int, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("LenLess", []any{object, n, msgAndArgs}, func(a *Assertions) bool {
+			return a.LenLess(object, n, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	l, ok := a.checkLen(object, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	if l >= n {
+		return a.Fail(fmt.Sprintf("\"%s\" should have fewer than %d item(s), but has %d", lenPreview(object), n, l), msgAndArgs...)
+	}
+	return true
+}
+
+// LenBetween asserts that the specified object has at least min and at most
+// max items. LenBetween also fails if the object has a type that len() not
+// accept.
+//
+//	a.LenBetween(page.Items, 1, 50)
+func (a *Assertions) LenBetween(object any, min, max int, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("LenBetween", []any{object, min, max, msgAndArgs}, func(a *Assertions) bool {
+			return a.LenBetween(object, min, max, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if min > max {
+		return a.Fail(fmt.Sprintf("Invalid length range [%d, %d]", min, max), msgAndArgs...)
+	}
+	l, ok := a.checkLen(object, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	if l < min || l > max {
+		return a.Fail(fmt.Sprintf("\"%s\" should have between %d and %d item(s), but has %d", lenPreview(object), min, max, l), msgAndArgs...)
+	}
+	return true
+}
+
+// NotLen asserts that the specified object does not have the given length.
+// NotLen also fails if the object has a type that len() not accept.
+//
+//	a.NotLen(batch, 0)
+func (a *Assertions) NotLen(object any, length int, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotLen", []any{object, length, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotLen(object, length, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	l, ok := a.checkLen(object, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	if l == length {
+		return a.Fail(fmt.Sprintf("\"%s\" should not have %d item(s)", lenPreview(object), length), msgAndArgs...)
+	}
+	return true
+}
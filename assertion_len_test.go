@@ -0,0 +1,76 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLenGreater(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.LenGreater([]int{1, 2}, 1))
+	New(t).True(mockAssertion.LenGreater("abc", 0))
+	New(t).False(mockAssertion.LenGreater([]int{1}, 1))
+	New(t).False(mockAssertion.LenGreater(map[int]int{}, 0))
+	New(t).False(mockAssertion.LenGreater(42, 0), "int does not have length")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).LenGreater([]string{"a"}, 3))
+	New(t).Contains(out.buf.String(), "\"[a]\" should have more than 3 item(s), but has 1")
+}
+
+func TestLenLess(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.LenLess([]int{1, 2}, 3))
+	New(t).True(mockAssertion.LenLess([]int(nil), 1))
+	New(t).False(mockAssertion.LenLess([]int{1, 2}, 2))
+	New(t).False(mockAssertion.LenLess(nil, 1), "nil does not have length")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).LenLess(map[string]int{"a": 1}, 1))
+	New(t).Contains(out.buf.String(), "\"map[a:1]\" should have fewer than 1 item(s), but has 1")
+}
+
+func TestLenBetween(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.LenBetween([]int{1, 2}, 1, 2))
+	New(t).True(mockAssertion.LenBetween([]int{1, 2}, 2, 5))
+	New(t).False(mockAssertion.LenBetween([]int{1, 2}, 3, 5))
+	New(t).False(mockAssertion.LenBetween([]int{1, 2}, 0, 1))
+	New(t).False(mockAssertion.LenBetween([]int{1, 2}, 2, 1))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).LenBetween(strings.Repeat("x", 200), 1, 50))
+	New(t).Contains(out.buf.String(), strings.Repeat("x", 100)+"<... truncated>\" should have between 1 and 50 item(s), but has 200")
+}
+
+func TestNotLen(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.NotLen([]int{1, 2}, 0))
+	New(t).False(mockAssertion.NotLen([]int{}, 0))
+	New(t).False(mockAssertion.NotLen(true, 0), "true does not have length")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NotLen([]int{7}, 1))
+	New(t).Contains(out.buf.String(), "\"[7]\" should not have 1 item(s)")
+}
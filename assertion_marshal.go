@@ -0,0 +1,127 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// MarshalFunc encodes a value into its serialized form, e.g. json.Marshal.
+type MarshalFunc func(v any) ([]byte, error)
+
+// UnmarshalFunc decodes data into the value pointed to by v, e.g. json.Unmarshal.
+type UnmarshalFunc func(data []byte, v any) error
+
+// RoundTrips asserts that encoding value with marshal and decoding the result
+// with unmarshal yields a value equal to the original one.
+//
+//	a.RoundTrips(order, json.Marshal, json.Unmarshal)
+func (a *Assertions) RoundTrips(value any, marshal MarshalFunc, unmarshal UnmarshalFunc, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("RoundTrips", []any{value, marshal, unmarshal, msgAndArgs}, func(a *Assertions) bool {
+			return a.RoundTrips(value, marshal, unmarshal, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if value == nil {
+		return a.Fail("Cannot round trip nil value", msgAndArgs...)
+	}
+
+	data, err := marshal(value)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Cannot marshal %#v:\n%+v", value, err), msgAndArgs...)
+	}
+
+	decoded := reflect.New(reflect.TypeOf(value))
+	if err := unmarshal(data, decoded.Interface()); err != nil {
+		return a.Fail(fmt.Sprintf("Cannot unmarshal into %T:\n%+v\n\nEncoding:\n%s", value, err, formatEncoding(data)), msgAndArgs...)
+	}
+
+	actual := decoded.Elem().Interface()
+	if !ObjectsAreEqual(value, actual) {
+		diff := a.diff(value, actual)
+		expected, actual := formatUnequalValues(value, actual)
+		return a.Fail(fmt.Sprintf("Not equal after round trip: \n"+
+			"expected: %s\n"+
+			"actual  : %s%s\n\n"+
+			"Encoding:\n%s", expected, actual, diff, formatEncoding(data)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// JSONRoundTrips asserts that value survives a round trip through encoding/json.
+func (a *Assertions) JSONRoundTrips(value any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("JSONRoundTrips", []any{value, msgAndArgs}, func(a *Assertions) bool {
+			return a.JSONRoundTrips(value, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.RoundTrips(value, json.Marshal, json.Unmarshal, msgAndArgs...)
+}
+
+// GobRoundTrips asserts that value survives a round trip through encoding/gob.
+func (a *Assertions) GobRoundTrips(value any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("GobRoundTrips", []any{value, msgAndArgs}, func(a *Assertions) bool {
+			return a.GobRoundTrips(value, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.RoundTrips(value, gobMarshal, gobUnmarshal, msgAndArgs...)
+}
+
+func gobMarshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobUnmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// formatEncoding renders an intermediate encoding as text when it is valid
+// UTF-8, and as a hex dump otherwise.
+func formatEncoding(data []byte) string {
+	if utf8.Valid(data) {
+		return truncatingFormat(string(data))
+	}
+	return hex.Dump(data)
+}
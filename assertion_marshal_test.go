@@ -0,0 +1,68 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type roundTripStruct struct {
+	Name  string
+	Count int
+	// hidden is never encoded, so it is lost on every round trip.
+	hidden string
+}
+
+func TestRoundTrips(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.RoundTrips(roundTripStruct{Name: "foo", Count: 1}, json.Marshal, json.Unmarshal))
+	New(t).True(mockAssertion.RoundTrips([]int{1, 2, 3}, json.Marshal, json.Unmarshal))
+	New(t).False(mockAssertion.RoundTrips(nil, json.Marshal, json.Unmarshal))
+	New(t).False(mockAssertion.RoundTrips(roundTripStruct{hidden: "bar"}, json.Marshal, json.Unmarshal))
+	New(t).False(mockAssertion.RoundTrips(make(chan int), json.Marshal, json.Unmarshal))
+	New(t).False(mockAssertion.RoundTrips(1, json.Marshal, func([]byte, any) error {
+		return errors.New("boom")
+	}))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).RoundTrips(roundTripStruct{Name: "foo", hidden: "bar"}, json.Marshal, json.Unmarshal))
+	New(t).Contains(out.buf.String(), "Not equal after round trip")
+	New(t).Contains(out.buf.String(), `"{\"Name\":\"foo\",\"Count\":0}"`)
+}
+
+func TestJSONRoundTrips(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.JSONRoundTrips(map[string]int{"a": 1}))
+	New(t).True(mockAssertion.JSONRoundTrips(&roundTripStruct{Name: "foo"}))
+	New(t).False(mockAssertion.JSONRoundTrips(roundTripStruct{hidden: "bar"}))
+}
+
+func TestGobRoundTrips(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.GobRoundTrips(roundTripStruct{Name: "foo", Count: 1}))
+	New(t).False(mockAssertion.GobRoundTrips(roundTripStruct{Name: "foo", hidden: "bar"}))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).GobRoundTrips(roundTripStruct{Name: "foo", hidden: "bar"}))
+	New(t).Contains(out.buf.String(), "00000000")
+}
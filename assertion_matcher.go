@@ -0,0 +1,179 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Matcher is an extension point for domain-specific expectations. Matches
+// reports whether actual satisfies the expectation and, when it does not,
+// a description of the mismatch.
+//
+// Matchers are accepted by MatchedBy, and as expected elements by Contains
+// and ElementsMatch.
+type Matcher interface {
+	Matches(actual any) (bool, string)
+}
+
+// MatchedBy asserts that actual satisfies matcher.
+//
+//	a.MatchedBy(resp, StatusCode(http.StatusOK))
+func (a *Assertions) MatchedBy(actual any, matcher Matcher, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("MatchedBy", []any{actual, matcher, msgAndArgs}, func(a *Assertions) bool {
+			return a.MatchedBy(actual, matcher, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if ok, description := matcher.Matches(actual); !ok {
+		return a.Fail(fmt.Sprintf("Not matched: %s\n"+
+			"actual  : %s", description, truncatingFormat(actual)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// matchesElement reports whether actual matches the expected element, which
+// is either a Matcher or a value compared with ObjectsAreEqual.
+func matchesElement(expected, actual any) bool {
+	if m, ok := expected.(Matcher); ok {
+		matched, _ := m.Matches(actual)
+		return matched
+	}
+	return ObjectsAreEqual(actual, expected)
+}
+
+// MatcherFunc adapts an ordinary function to a Matcher.
+type MatcherFunc func(actual any) (bool, string)
+
+// Matches calls f(actual).
+func (f MatcherFunc) Matches(actual any) (bool, string) {
+	return f(actual)
+}
+
+// BeNil returns a Matcher for nil values, including typed nil pointers,
+// maps, slices, channels and functions.
+func BeNil() Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		if isNil(actual) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected nil, got %s", truncatingFormat(actual))
+	})
+}
+
+// EqualTo returns a Matcher for values equal to expected, as Equal
+// compares them.
+func EqualTo(expected any) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		if ObjectsAreEqual(expected, actual) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %s", truncatingFormat(expected))
+	})
+}
+
+// HaveLen returns a Matcher for values of the given length.
+func HaveLen(length int) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		ok, l := getLen(actual)
+		if !ok {
+			return false, fmt.Sprintf("expected length %d, but %T has no length", length, actual)
+		}
+		if l != length {
+			return false, fmt.Sprintf("expected length %d, got %d", length, l)
+		}
+		return true, ""
+	})
+}
+
+// ContainElement returns a Matcher for strings, slices, arrays and maps that
+// contain element, as Contains checks them. The element may be a Matcher.
+func ContainElement(element any) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		ok, found := containsElement(actual, element)
+		if !ok {
+			return false, fmt.Sprintf("expected to contain %s, but %T cannot contain elements", truncatingFormat(element), actual)
+		}
+		if !found {
+			return false, fmt.Sprintf("expected to contain %s", truncatingFormat(element))
+		}
+		return true, ""
+	})
+}
+
+// WithTransform returns a Matcher that applies transform to the actual
+// value and matches the result with matcher.
+//
+//	a.MatchedBy(user, assert.WithTransform(func(u any) any {
+//		return u.(*User).Email
+//	}, assert.ContainElement("@")))
+func WithTransform(transform func(actual any) any, matcher Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		transformed := transform(actual)
+		if ok, description := matcher.Matches(transformed); !ok {
+			return false, fmt.Sprintf("after transform to %s: %s", truncatingFormat(transformed), description)
+		}
+		return true, ""
+	})
+}
+
+// And returns a Matcher that matches when all of matchers match. It reports
+// the first mismatch.
+func And(matchers ...Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		for _, m := range matchers {
+			if ok, description := m.Matches(actual); !ok {
+				return false, description
+			}
+		}
+		return true, ""
+	})
+}
+
+// Or returns a Matcher that matches when any of matchers matches. It
+// reports every mismatch.
+func Or(matchers ...Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		descriptions := make([]string, 0, len(matchers))
+		for _, m := range matchers {
+			ok, description := m.Matches(actual)
+			if ok {
+				return true, ""
+			}
+			descriptions = append(descriptions, description)
+		}
+		return false, "none matched: " + strings.Join(descriptions, "; ")
+	})
+}
+
+// Negate returns a Matcher that matches when matcher does not. It is the
+// counterpart of Not for matchers.
+func Negate(matcher Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		if ok, _ := matcher.Matches(actual); ok {
+			return false, fmt.Sprintf("expected not to match, but %s did", truncatingFormat(actual))
+		}
+		return true, ""
+	})
+}
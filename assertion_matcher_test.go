@@ -0,0 +1,108 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// prefixMatcher matches strings with the given prefix.
+type prefixMatcher string
+
+func (m prefixMatcher) Matches(actual any) (bool, string) {
+	s, ok := actual.(string)
+	if !ok {
+		return false, fmt.Sprintf("expected a string with prefix %q, got %T", string(m), actual)
+	}
+	if !strings.HasPrefix(s, string(m)) {
+		return false, fmt.Sprintf("expected prefix %q", string(m))
+	}
+	return true, ""
+}
+
+func TestMatchedBy(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.MatchedBy("order-1", prefixMatcher("order-")))
+	New(t).False(mockAssertion.MatchedBy("user-1", prefixMatcher("order-")))
+	New(t).False(mockAssertion.MatchedBy(1, prefixMatcher("order-")))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).MatchedBy("user-1", prefixMatcher("order-"), "checkout"))
+	New(t).Contains(out.buf.String(), `Not matched: expected prefix "order-"`)
+	New(t).Contains(out.buf.String(), `actual  : "user-1"`)
+	New(t).Contains(out.buf.String(), "checkout")
+}
+
+func TestContainsMatcher(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.Contains([]string{"user-1", "order-2"}, prefixMatcher("order-")))
+	New(t).False(mockAssertion.Contains([]string{"user-1"}, prefixMatcher("order-")))
+	New(t).True(mockAssertion.Contains(map[string]int{"order-1": 1}, prefixMatcher("order-")))
+	New(t).False(mockAssertion.NotContains([]any{1, "order-2"}, prefixMatcher("order-")))
+}
+
+func TestElementsMatchMatcher(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.ElementsMatch([]any{prefixMatcher("order-"), "user-1"}, []any{"user-1", "order-9"}))
+	New(t).False(mockAssertion.ElementsMatch([]any{prefixMatcher("order-"), "user-1"}, []any{"user-1", "user-2"}))
+	New(t).False(mockAssertion.ElementsMatch([]any{prefixMatcher("order-"), prefixMatcher("order-")}, []any{"order-1"}))
+}
+
+func TestBuiltinMatchers(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	var nilMap map[string]int
+	New(t).True(mockAssertion.MatchedBy(nil, BeNil()))
+	New(t).True(mockAssertion.MatchedBy(nilMap, BeNil()))
+	New(t).False(mockAssertion.MatchedBy(0, BeNil()))
+	New(t).True(mockAssertion.MatchedBy([]int{1, 2}, EqualTo([]int{1, 2})))
+	New(t).False(mockAssertion.MatchedBy([]int{1, 2}, EqualTo([]int{2, 1})))
+	New(t).True(mockAssertion.MatchedBy("abc", HaveLen(3)))
+	New(t).False(mockAssertion.MatchedBy(3, HaveLen(3)))
+	New(t).True(mockAssertion.MatchedBy([]string{"user-1", "order-2"}, ContainElement(prefixMatcher("order-"))))
+	New(t).False(mockAssertion.MatchedBy([]string{"user-1"}, ContainElement("user-2")))
+	New(t).False(mockAssertion.MatchedBy(42, ContainElement(4)))
+	New(t).True(mockAssertion.MatchedBy("order-1", And(prefixMatcher("order-"), HaveLen(7))))
+	New(t).False(mockAssertion.MatchedBy("order-10", And(prefixMatcher("order-"), HaveLen(7))))
+	New(t).True(mockAssertion.MatchedBy("user-1", Or(prefixMatcher("order-"), prefixMatcher("user-"))))
+	New(t).True(mockAssertion.MatchedBy("user-1", Negate(prefixMatcher("order-"))))
+	New(t).False(mockAssertion.MatchedBy(nil, Negate(BeNil())))
+
+	length := func(v any) any { return len(v.(string)) }
+	New(t).True(mockAssertion.MatchedBy("tison", WithTransform(length, EqualTo(5))))
+
+	for _, tc := range []struct {
+		matcher Matcher
+		message string
+	}{
+		{BeNil(), `expected nil, got "x"`},
+		{HaveLen(2), "expected length 2, got 1"},
+		{ContainElement("y"), `expected to contain "y"`},
+		{WithTransform(length, Or(EqualTo(2), EqualTo(3))), "after transform to 1: none matched: expected 2; expected 3"},
+		{Negate(EqualTo("x")), `expected not to match, but "x" did`},
+	} {
+		out := &outputT{buf: bytes.NewBuffer(nil)}
+		New(t).False(NewWithOnFailureNoop(out).MatchedBy("x", tc.matcher))
+		New(t).Contains(out.buf.String(), "Not matched: "+tc.message)
+	}
+}
@@ -51,7 +51,7 @@ func (a *Assertions) isOrdered(object any, allowedComparesResults []CompareType,
 		}
 
 		if !containsValue(allowedComparesResults, compareResult) {
-			return a.Fail(fmt.Sprintf(failMessage, prevValue, value), msgAndArgs...)
+			return a.Fail(fmt.Sprintf(failMessage, formatCompareValue(prevValueInterface), formatCompareValue(valueInterface)), msgAndArgs...)
 		}
 	}
 
@@ -60,20 +60,52 @@ func (a *Assertions) isOrdered(object any, allowedComparesResults []CompareType,
 
 // IsIncreasing asserts that the collection is increasing
 func (a *Assertions) IsIncreasing(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("IsIncreasing", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.IsIncreasing(object, msgAndArgs...)
+		})
+	}
 	return a.isOrdered(object, []CompareType{compareLess}, "\"%v\" is not less than \"%v\"", msgAndArgs...)
 }
 
 // IsNonIncreasing asserts that the collection is not increasing
 func (a *Assertions) IsNonIncreasing(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("IsNonIncreasing", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.IsNonIncreasing(object, msgAndArgs...)
+		})
+	}
 	return a.isOrdered(object, []CompareType{compareEqual, compareGreater}, "\"%v\" is not greater than or equal to \"%v\"", msgAndArgs...)
 }
 
 // IsDecreasing asserts that the collection is decreasing
 func (a *Assertions) IsDecreasing(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("IsDecreasing", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.IsDecreasing(object, msgAndArgs...)
+		})
+	}
 	return a.isOrdered(object, []CompareType{compareGreater}, "\"%v\" is not greater than \"%v\"", msgAndArgs...)
 }
 
 // IsNonDecreasing asserts that the collection is not decreasing
 func (a *Assertions) IsNonDecreasing(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("IsNonDecreasing", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.IsNonDecreasing(object, msgAndArgs...)
+		})
+	}
 	return a.isOrdered(object, []CompareType{compareLess, compareEqual}, "\"%v\" is not less than or equal to \"%v\"", msgAndArgs...)
 }
@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !assert_disabled
+
 package assert
 
 import (
@@ -172,3 +174,13 @@ func TestOrderingMsgAndArgsForwarding(t *testing.T) {
 		New(t).Contains(out.buf.String(), expectedOutput)
 	}
 }
+
+func TestIsIncreasingComposite(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.IsIncreasing([][]byte{[]byte("a"), []byte("ab"), []byte("b")}))
+	New(t).False(mockAssertion.IsIncreasing([][]byte{[]byte("b"), []byte("a")}))
+	New(t).True(mockAssertion.IsIncreasing([][]uint64{{1, 0}, {1, 2}, {2}}))
+	New(t).True(mockAssertion.IsNonDecreasing([][2]int{{1, 1}, {1, 1}, {1, 2}}))
+	New(t).False(mockAssertion.IsDecreasing([][]int{{1}, {1}}))
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// captureMu serializes output captures, as they swap process wide files.
+var captureMu sync.Mutex
+
+// CaptureOutput runs f with os.Stdout and os.Stderr redirected, and returns
+// what f wrote to each of them. The original files are restored before
+// CaptureOutput returns, even when f panics, in which case the panic is
+// propagated.
+//
+// Output written by other goroutines while f runs is captured too.
+func (a *Assertions) CaptureOutput(f func()) (stdout, stderr string) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	captureMu.Lock()
+	defer captureMu.Unlock()
+
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		a.Fail(fmt.Sprintf("Cannot capture stdout: %s", err))
+		return "", ""
+	}
+	errR, errW, err := os.Pipe()
+	if err != nil {
+		_ = outR.Close()
+		_ = outW.Close()
+		a.Fail(fmt.Sprintf("Cannot capture stderr: %s", err))
+		return "", ""
+	}
+
+	outC := drain(outR)
+	errC := drain(errR)
+
+	origStdout, origStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = outW, errW
+	defer func() {
+		os.Stdout, os.Stderr = origStdout, origStderr
+		_ = outW.Close()
+		_ = errW.Close()
+		stdout, stderr = <-outC, <-errC
+	}()
+
+	f()
+	return
+}
+
+// drain reads r in the background until EOF and delivers its content.
+func drain(r *os.File) <-chan string {
+	c := make(chan string, 1)
+	go func() {
+		defer r.Close()
+		var buf bytes.Buffer
+		_, _ = io.Copy(&buf, r)
+		c <- buf.String()
+	}()
+	return c
+}
+
+// PrintsToStdout asserts that f writes exactly the expected string to
+// os.Stdout.
+func (a *Assertions) PrintsToStdout(f func(), expected string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("PrintsToStdout", []any{f, expected, msgAndArgs}, func(a *Assertions) bool {
+			return a.PrintsToStdout(f, expected, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	stdout, _ := a.CaptureOutput(f)
+	return a.Equal(expected, stdout, msgAndArgs...)
+}
+
+// PrintsToStderr asserts that f writes exactly the expected string to
+// os.Stderr.
+func (a *Assertions) PrintsToStderr(f func(), expected string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("PrintsToStderr", []any{f, expected, msgAndArgs}, func(a *Assertions) bool {
+			return a.PrintsToStderr(f, expected, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	_, stderr := a.CaptureOutput(f)
+	return a.Equal(expected, stderr, msgAndArgs...)
+}
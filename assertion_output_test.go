@@ -0,0 +1,58 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestCaptureOutput(t *testing.T) {
+	origStdout, origStderr := os.Stdout, os.Stderr
+
+	stdout, stderr := New(t).CaptureOutput(func() {
+		fmt.Print("hello")
+		fmt.Fprint(os.Stderr, "world")
+	})
+	New(t).Equal("hello", stdout)
+	New(t).Equal("world", stderr)
+	New(t).Same(origStdout, os.Stdout)
+	New(t).Same(origStderr, os.Stderr)
+}
+
+func TestCaptureOutputRestoresOnPanic(t *testing.T) {
+	origStdout, origStderr := os.Stdout, os.Stderr
+
+	New(t).PanicsWithValue("boom", func() {
+		New(t).CaptureOutput(func() {
+			fmt.Print("before panic")
+			panic("boom")
+		})
+	})
+	New(t).Same(origStdout, os.Stdout)
+	New(t).Same(origStderr, os.Stderr)
+}
+
+func TestPrintsToStdout(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.PrintsToStdout(func() { fmt.Println("hello") }, "hello\n"))
+	New(t).False(mockAssertion.PrintsToStdout(func() { fmt.Fprintln(os.Stderr, "hello") }, "hello\n"))
+	New(t).True(mockAssertion.PrintsToStderr(func() { fmt.Fprintln(os.Stderr, "hello") }, "hello\n"))
+	New(t).False(mockAssertion.PrintsToStderr(func() {}, "hello\n"))
+}
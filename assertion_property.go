@@ -0,0 +1,144 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// defaultForAllRuns is the number of inputs ForAll checks unless configured
+// with ForAllRuns.
+const defaultForAllRuns = 100
+
+// ForAllOption configures ForAll.
+type ForAllOption func(*forAllConfig)
+
+type forAllConfig struct {
+	runs     int
+	seed     int64
+	shrinker Shrinker
+}
+
+// ForAllRuns sets the number of generated inputs ForAll checks.
+func ForAllRuns(n int) ForAllOption {
+	return func(c *forAllConfig) {
+		c.runs = n
+	}
+}
+
+// ForAllSeed sets the seed of the random source passed to the generator, so
+// that a failure reported by ForAll can be reproduced.
+func ForAllSeed(seed int64) ForAllOption {
+	return func(c *forAllConfig) {
+		c.seed = seed
+	}
+}
+
+// ForAllShrink sets the Shrinker ForAll uses to minimize a failing input.
+// By default ForAll uses DefaultShrinker; a nil shrinker disables shrinking.
+func ForAllShrink(shrinker Shrinker) ForAllOption {
+	return func(c *forAllConfig) {
+		c.shrinker = shrinker
+	}
+}
+
+// ForAll checks that property holds for inputs produced by generator. The
+// property asserts through an Assertions derived from a; the first input it
+// fails for is shrunk to a minimal failing input, then reported along with
+// the seed of the random source and the shrink trail. No further inputs are
+// checked.
+//
+//	a.ForAll(func(r *rand.Rand) any {
+//		return r.Intn(1000)
+//	}, func(a *assert.Assertions, v any) {
+//		n := v.(int)
+//		a.Equal(n, Abs(-n))
+//	}, assert.ForAllRuns(500))
+func (a *Assertions) ForAll(generator func(r *rand.Rand) any, property func(a *Assertions, v any), opts ...ForAllOption) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ForAll", []any{generator, property, opts}, func(a *Assertions) bool {
+			return a.ForAll(generator, property, opts...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	config := forAllConfig{runs: defaultForAllRuns, seed: time.Now().UnixNano(), shrinker: DefaultShrinker}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	r := rand.New(rand.NewSource(config.seed))
+	for run := 1; run <= config.runs; run++ {
+		v := generator(r)
+		failures := checkProperty(a, property, v)
+		if len(failures) > 0 {
+			msg := fmt.Sprintf("Property failed on run %d of %d (seed %d)\n"+
+				"input: %s", run, config.runs, config.seed, truncatingFormat(v))
+			if trail, shrunkFailures := shrinkInput(a, property, config.shrinker, v); len(trail) > 1 {
+				formatted := make([]string, len(trail))
+				for i, step := range trail {
+					formatted[i] = truncatingFormat(step)
+				}
+				msg += fmt.Sprintf("\nshrunk: %s\nshrink trail: %s", formatted[len(formatted)-1], strings.Join(formatted, " -> "))
+				failures = shrunkFailures
+			}
+			return a.Fail(msg + "\n" + strings.Join(failures, "\n"))
+		}
+	}
+
+	return true
+}
+
+func checkProperty(a *Assertions, property func(a *Assertions, v any), v any) []string {
+	return runRecorded(a, func(a *Assertions) {
+		property(a, v)
+	})
+}
+
+// maxShrinkSteps bounds the number of successful shrinks of a failing input.
+const maxShrinkSteps = 1000
+
+// shrinkInput repeatedly replaces the failing input v with the first of its
+// shrink candidates that still fails the property. It returns the trail of
+// failing inputs, starting with v, and the failures of the last one.
+func shrinkInput(a *Assertions, property func(a *Assertions, v any), shrinker Shrinker, v any) ([]any, []string) {
+	trail := []any{v}
+	var failures []string
+	if shrinker == nil {
+		return trail, failures
+	}
+
+	for step := 0; step < maxShrinkSteps; step++ {
+		shrunk := false
+		for _, candidate := range shrinker(v) {
+			if f := checkProperty(a, property, candidate); len(f) > 0 {
+				v, failures, shrunk = candidate, f, true
+				trail = append(trail, v)
+				break
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return trail, failures
+}
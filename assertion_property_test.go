@@ -0,0 +1,104 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestForAll(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	runs := 0
+	New(t).True(mockAssertion.ForAll(func(r *rand.Rand) any {
+		return r.Intn(100)
+	}, func(a *Assertions, v any) {
+		runs++
+		a.GreaterOrEqual(v.(int), 0)
+	}, ForAllRuns(50)))
+	New(t).Equal(50, runs)
+
+	New(t).False(mockAssertion.ForAll(func(r *rand.Rand) any {
+		return r.Intn(100)
+	}, func(a *Assertions, v any) {
+		panic("boom")
+	}))
+
+	generate := func(seed int64) []int {
+		var values []int
+		mockAssertion.ForAll(func(r *rand.Rand) any {
+			return r.Int()
+		}, func(a *Assertions, v any) {
+			values = append(values, v.(int))
+		}, ForAllRuns(5), ForAllSeed(seed))
+		return values
+	}
+	New(t).Equal(generate(42), generate(42))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	runs = 0
+	New(t).False(New(out).ForAll(func(r *rand.Rand) any {
+		return r.Intn(10) + 10
+	}, func(a *Assertions, v any) {
+		runs++
+		a.Less(v.(int), 10, "value must be a digit")
+		a.Fail("not reached")
+	}, ForAllSeed(7), ForAllShrink(nil)))
+	New(t).Equal(1, runs)
+	New(t).Contains(out.buf.String(), "Property failed on run 1 of 100 (seed 7)")
+	New(t).Contains(out.buf.String(), "input: ")
+	New(t).Contains(out.buf.String(), "value must be a digit")
+	New(t).NotContains(out.buf.String(), "not reached")
+}
+
+func TestForAllShrink(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(New(out).ForAll(func(r *rand.Rand) any {
+		return 100 + r.Intn(100)
+	}, func(a *Assertions, v any) {
+		a.Less(v.(int), 10)
+	}))
+	New(t).Contains(out.buf.String(), "shrunk: 10\n")
+	New(t).Contains(out.buf.String(), "shrink trail: ")
+	New(t).Contains(out.buf.String(), "-> 10\n")
+	New(t).Contains(out.buf.String(), `"10" is not less than "10"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(New(out).ForAll(func(r *rand.Rand) any {
+		s := make([]int, 5+r.Intn(5))
+		for i := range s {
+			s[i] = r.Intn(100)
+		}
+		return s
+	}, func(a *Assertions, v any) {
+		for _, n := range v.([]int) {
+			a.NotEqual(7, n%10)
+		}
+	}, ForAllRuns(1000), ForAllSeed(1)))
+	New(t).Regexp(`shrunk: \[\]int\{\d*7\}\n`, out.buf.String())
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(New(out).ForAll(func(r *rand.Rand) any {
+		return 100
+	}, func(a *Assertions, v any) {
+		a.Less(v.(int), 10)
+	}, ForAllShrink(nil)))
+	New(t).NotContains(out.buf.String(), "shrunk")
+	New(t).Contains(out.buf.String(), `"100" is not less than "10"`)
+}
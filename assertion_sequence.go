@@ -0,0 +1,176 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ZipEqual asserts that two arrays or slices are equal element by element.
+// Unlike Equal, which reports one diff of the whole sequences, ZipEqual
+// reports every differing index on its own, followed by a summary of the
+// elements that are missing or unexpected when the lengths do not match.
+//
+//	a.ZipEqual([]int{1, 2, 3}, []int{1, 5, 3})
+func (a *Assertions) ZipEqual(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ZipEqual", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.ZipEqual(expected, actual, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if isEmpty(expected) && isEmpty(actual) {
+		return true
+	}
+
+	if !a.isList(expected, msgAndArgs...) || !a.isList(actual, msgAndArgs...) {
+		return false
+	}
+
+	ev := reflect.ValueOf(expected)
+	av := reflect.ValueOf(actual)
+	n := ev.Len()
+	if av.Len() < n {
+		n = av.Len()
+	}
+
+	var msg bytes.Buffer
+	differ := 0
+	for i := 0; i < n; i++ {
+		e, x := ev.Index(i).Interface(), av.Index(i).Interface()
+		if ObjectsAreEqual(e, x) {
+			continue
+		}
+		differ++
+		es, xs := formatUnequalValues(e, x)
+		fmt.Fprintf(&msg, "\n[%d]:\n\texpected: %s\n\tactual  : %s", i, es, xs)
+		if d := a.diff(e, x); d != "" {
+			msg.WriteString("\n\t")
+			msg.WriteString(strings.ReplaceAll(strings.TrimSpace(d), "\n", "\n\t"))
+		}
+	}
+
+	if differ == 0 && ev.Len() == av.Len() {
+		return true
+	}
+
+	var summary bytes.Buffer
+	if differ > 0 {
+		fmt.Fprintf(&summary, "Sequences differ at %d index(es):", differ)
+		summary.Write(msg.Bytes())
+	}
+	if ev.Len() != av.Len() {
+		if summary.Len() > 0 {
+			summary.WriteString("\n\n")
+		}
+		fmt.Fprintf(&summary, "Length mismatch: expected %d item(s), actual %d item(s)", ev.Len(), av.Len())
+		for i := n; i < ev.Len(); i++ {
+			fmt.Fprintf(&summary, "\n[%d]: missing %s", i, truncatingFormat(ev.Index(i).Interface()))
+		}
+		for i := n; i < av.Len(); i++ {
+			fmt.Fprintf(&summary, "\n[%d]: unexpected %s", i, truncatingFormat(av.Index(i).Interface()))
+		}
+	}
+
+	return a.withValues(expected, actual).Fail(summary.String(), msgAndArgs...)
+}
+
+// sortedByKey returns a copy of the array or slice list sorted by key. It
+// returns false if two keys cannot be compared.
+func sortedByKey(list any, key func(el any) any) (any, bool) {
+	v := reflect.ValueOf(list)
+	keys := make([]any, v.Len())
+	for i := range keys {
+		keys[i] = key(v.Index(i).Interface())
+	}
+	for _, k := range keys {
+		if reflect.TypeOf(k) != reflect.TypeOf(keys[0]) {
+			return nil, false
+		}
+		if _, ok := compare(keys[0], k, reflect.ValueOf(k).Kind()); !ok {
+			return nil, false
+		}
+	}
+	indices := make([]int, len(keys))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		ki, kj := keys[indices[i]], keys[indices[j]]
+		result, _ := compare(ki, kj, reflect.ValueOf(ki).Kind())
+		return result == compareLess
+	})
+
+	sorted := reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), v.Len(), v.Len())
+	for i, idx := range indices {
+		sorted.Index(i).Set(v.Index(idx))
+	}
+	return sorted.Interface(), true
+}
+
+// EqualSortedBy asserts that two arrays or slices are equal once both are
+// sorted by the key extracted from each element. The order of the elements
+// is intentionally ignored, but unlike ElementsMatch, the failure message
+// shows a diff of the sorted elements. Keys must be of a type supported by
+// Greater and Less, such as numbers, strings and times.
+//
+//	a.EqualSortedBy(want, got, func(el any) any { return el.(User).ID })
+func (a *Assertions) EqualSortedBy(expected, actual any, key func(el any) any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EqualSortedBy", []any{expected, actual, key, msgAndArgs}, func(a *Assertions) bool {
+			return a.EqualSortedBy(expected, actual, key, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if isEmpty(expected) && isEmpty(actual) {
+		return true
+	}
+	if !a.isList(expected, msgAndArgs...) || !a.isList(actual, msgAndArgs...) {
+		return false
+	}
+
+	sortedExpected, ok := sortedByKey(expected, key)
+	if !ok {
+		return a.Fail(fmt.Sprintf("Can not compare the keys of %s", truncatingFormat(expected)), msgAndArgs...)
+	}
+	sortedActual, ok := sortedByKey(actual, key)
+	if !ok {
+		return a.Fail(fmt.Sprintf("Can not compare the keys of %s", truncatingFormat(actual)), msgAndArgs...)
+	}
+
+	if !ObjectsAreEqual(sortedExpected, sortedActual) {
+		diff := a.diff(sortedExpected, sortedActual)
+		e, x := formatUnequalValues(sortedExpected, sortedActual)
+		return a.withValues(expected, actual).Fail(fmt.Sprintf("Not equal after sorting by key: \n"+
+			"expected: %s\n"+
+			"actual  : %s%s", e, x, diff), msgAndArgs...)
+	}
+
+	return true
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestZipEqual(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.ZipEqual([]int{1, 2, 3}, []int{1, 2, 3}))
+	New(t).True(mockAssertion.ZipEqual([...]string{"a"}, []string{"a"}))
+	New(t).True(mockAssertion.ZipEqual(nil, []int{}))
+	New(t).False(mockAssertion.ZipEqual([]int{1, 2}, []int{2, 1}))
+	New(t).False(mockAssertion.ZipEqual([]int{1}, []int{1, 2}))
+	New(t).False(mockAssertion.ZipEqual(1, 1), "int is not a sequence")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).ZipEqual([]int{1, 2, 3, 4}, []int{1, 5, 3}))
+	New(t).Contains(out.buf.String(), "Sequences differ at 1 index(es):")
+	New(t).Contains(out.buf.String(), "[1]:")
+	New(t).Contains(out.buf.String(), "expected: 2")
+	New(t).Contains(out.buf.String(), "actual  : 5")
+	New(t).Contains(out.buf.String(), "Length mismatch: expected 4 item(s), actual 3 item(s)")
+	New(t).Contains(out.buf.String(), "[3]: missing 4")
+
+	type item struct {
+		Name  string
+		Price int
+	}
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).ZipEqual(
+		[]item{{"apple", 1}, {"pear", 2}},
+		[]item{{"apple", 1}, {"pear", 3}, {"fig", 4}},
+	))
+	New(t).Contains(out.buf.String(), "[1]:")
+	New(t).Contains(out.buf.String(), "- Price: (int) 2")
+	New(t).Contains(out.buf.String(), "+ Price: (int) 3")
+	New(t).Contains(out.buf.String(), "[2]: unexpected assert.item{Name:\"fig\", Price:4}")
+	New(t).NotContains(out.buf.String(), "[0]:")
+}
+
+func TestEqualSortedBy(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	type user struct {
+		ID   int
+		Name string
+	}
+	byID := func(el any) any { return el.(user).ID }
+
+	New(t).True(mockAssertion.EqualSortedBy(
+		[]user{{1, "a"}, {2, "b"}, {3, "c"}},
+		[]user{{3, "c"}, {1, "a"}, {2, "b"}},
+		byID,
+	))
+	New(t).True(mockAssertion.EqualSortedBy([]string{"b", "a"}, [2]string{"a", "b"}, func(el any) any { return el }))
+	New(t).True(mockAssertion.EqualSortedBy(nil, []user{}, byID))
+	New(t).False(mockAssertion.EqualSortedBy([]user{{1, "a"}}, []user{{1, "a"}, {2, "b"}}, byID))
+	New(t).False(mockAssertion.EqualSortedBy([]user{{1, "a"}}, []user{{1, "a"}}, func(el any) any { return el }), "structs are not ordered")
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).EqualSortedBy(
+		[]user{{2, "bob"}, {1, "alice"}},
+		[]user{{1, "alice"}, {2, "robert"}},
+		byID,
+	))
+	New(t).Contains(out.buf.String(), "Not equal after sorting by key:")
+	New(t).Contains(out.buf.String(), `-  Name: (string) (len=3) "bob"`)
+	New(t).Contains(out.buf.String(), `+  Name: (string) (len=6) "robert"`)
+}
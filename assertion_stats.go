@@ -0,0 +1,259 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// toFloatSlice converts a slice or array of numbers to float64 values.
+func toFloatSlice(samples any) ([]float64, error) {
+	v := reflect.ValueOf(samples)
+	if samples == nil || v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("Samples must be a slice of numbers, got %T", samples)
+	}
+	if v.Len() == 0 {
+		return nil, fmt.Errorf("Samples must not be empty")
+	}
+	values := make([]float64, v.Len())
+	for i := range values {
+		f, ok := toFloat(v.Index(i).Interface())
+		if !ok {
+			return nil, fmt.Errorf("Sample [%d] is not a number: %#v", i, v.Index(i).Interface())
+		}
+		values[i] = f
+	}
+	return values, nil
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// stdDev returns the population standard deviation of values.
+func stdDev(values []float64) float64 {
+	m := mean(values)
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return math.Sqrt(sum / float64(len(values)))
+}
+
+// percentile returns the p-th percentile (0 < p <= 1) of values using the
+// nearest-rank method.
+func percentile(values []float64, p float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// sampleSummary describes values for failure messages.
+func sampleSummary(values []float64) string {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return fmt.Sprintf("n=%d min=%v max=%v mean=%v stddev=%v",
+		len(values), sorted[0], sorted[len(sorted)-1], mean(values), stdDev(values))
+}
+
+// MeanInDelta asserts that the mean of a slice of numbers is within delta of
+// expected.
+//
+//	a.MeanInDelta([]float64{9.8, 10.1, 10.2}, 10, 0.5)
+func (a *Assertions) MeanInDelta(samples any, expected, delta float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("MeanInDelta", []any{samples, expected, delta, msgAndArgs}, func(a *Assertions) bool {
+			return a.MeanInDelta(samples, expected, delta, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	values, err := toFloatSlice(samples)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
+	m := mean(values)
+	if math.IsNaN(m) || math.Abs(m-expected) > delta {
+		return a.Fail(fmt.Sprintf("Mean %v is not within %v of %v, difference is %v\n(%s)",
+			m, delta, expected, math.Abs(m-expected), sampleSummary(values)), msgAndArgs...)
+	}
+	return true
+}
+
+// PercentileLE asserts that the p-th percentile (0 < p <= 1) of a slice of
+// numbers is less than or equal to threshold. Percentiles use the
+// nearest-rank method.
+//
+//	a.PercentileLE(latencies, 0.99, 250)
+func (a *Assertions) PercentileLE(samples any, p, threshold float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("PercentileLE", []any{samples, p, threshold, msgAndArgs}, func(a *Assertions) bool {
+			return a.PercentileLE(samples, p, threshold, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !(p > 0 && p <= 1) {
+		return a.Fail(fmt.Sprintf("Percentile must be in (0, 1], got %v", p), msgAndArgs...)
+	}
+	values, err := toFloatSlice(samples)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
+	if v := percentile(values, p); !(v <= threshold) {
+		return a.Fail(fmt.Sprintf("p%v %v is greater than %v\n(%s)",
+			p*100, v, threshold, sampleSummary(values)), msgAndArgs...)
+	}
+	return true
+}
+
+// StdDevLE asserts that the population standard deviation of a slice of
+// numbers is less than or equal to threshold.
+//
+//	a.StdDevLE(latencies, 20)
+func (a *Assertions) StdDevLE(samples any, threshold float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("StdDevLE", []any{samples, threshold, msgAndArgs}, func(a *Assertions) bool {
+			return a.StdDevLE(samples, threshold, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	values, err := toFloatSlice(samples)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
+	if sd := stdDev(values); !(sd <= threshold) {
+		return a.Fail(fmt.Sprintf("Standard deviation %v is greater than %v\n(%s)",
+			sd, threshold, sampleSummary(values)), msgAndArgs...)
+	}
+	return true
+}
+
+// HistogramMatches asserts that samples fall into buckets in the proportions
+// given by expectedCounts, within tolerance. buckets holds n+1 ascending
+// boundaries of n half-open buckets [buckets[i], buckets[i+1]), the last one
+// closed; expectedCounts holds n counts or weights, which are normalized
+// before comparison. tolerance is the allowed absolute difference of each
+// bucket's share of the samples.
+//
+//	// a fair die rolled 6000 times
+//	a.HistogramMatches(rolls, []float64{1, 2, 3, 4, 5, 6, 7},
+//		[]float64{1, 1, 1, 1, 1, 1}, 0.02)
+func (a *Assertions) HistogramMatches(samples any, buckets, expectedCounts []float64, tolerance float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("HistogramMatches", []any{samples, buckets, expectedCounts, tolerance, msgAndArgs}, func(a *Assertions) bool {
+			return a.HistogramMatches(samples, buckets, expectedCounts, tolerance, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(buckets) < 2 || len(expectedCounts) != len(buckets)-1 {
+		return a.Fail(fmt.Sprintf("Expected %d bucket boundaries for %d expected count(s), got %d",
+			len(expectedCounts)+1, len(expectedCounts), len(buckets)), msgAndArgs...)
+	}
+	if !sort.Float64sAreSorted(buckets) {
+		return a.Fail(fmt.Sprintf("Bucket boundaries must be ascending: %v", buckets), msgAndArgs...)
+	}
+	var total float64
+	for _, c := range expectedCounts {
+		if c < 0 {
+			return a.Fail(fmt.Sprintf("Expected counts must not be negative: %v", expectedCounts), msgAndArgs...)
+		}
+		total += c
+	}
+	if total == 0 {
+		return a.Fail("Expected counts must not all be zero", msgAndArgs...)
+	}
+	values, err := toFloatSlice(samples)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
+
+	counts := make([]int, len(expectedCounts))
+	outside := 0
+	for _, v := range values {
+		i := sort.Search(len(buckets), func(i int) bool { return buckets[i] > v }) - 1
+		if i == len(buckets)-1 && v == buckets[i] {
+			i--
+		}
+		if i < 0 || i >= len(counts) {
+			outside++
+			continue
+		}
+		counts[i]++
+	}
+
+	var b strings.Builder
+	mismatch := false
+	n := float64(len(values))
+	for i, c := range counts {
+		got, want := float64(c)/n, expectedCounts[i]/total
+		mark := " "
+		if math.Abs(got-want) > tolerance {
+			mark, mismatch = "!", true
+		}
+		fmt.Fprintf(&b, "\n%s [%v, %v%s: %d (%.4f), expected %.4f",
+			mark, buckets[i], buckets[i+1], closeBracket(i == len(counts)-1), c, got, want)
+	}
+	if outside > 0 {
+		mismatch = true
+		fmt.Fprintf(&b, "\n! outside [%v, %v]: %d", buckets[0], buckets[len(buckets)-1], outside)
+	}
+	if mismatch {
+		return a.Fail(fmt.Sprintf("%d sample(s) do not match the expected distribution within %v:%s",
+			len(values), tolerance, b.String()), msgAndArgs...)
+	}
+	return true
+}
+
+func closeBracket(closed bool) string {
+	if closed {
+		return "]"
+	}
+	return ")"
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMeanInDelta(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.MeanInDelta([]float64{9.5, 10, 10.5}, 10, 0.1))
+	New(t).True(mockAssertion.MeanInDelta([]int{1, 2, 3, 4}, 2, 0.5))
+	New(t).True(mockAssertion.MeanInDelta([3]uint8{1, 2, 3}, 2, 0))
+	New(t).False(mockAssertion.MeanInDelta([]int{1, 2, 3, 4}, 2, 0.4))
+	New(t).False(mockAssertion.MeanInDelta([]int{}, 0, 1))
+	New(t).False(mockAssertion.MeanInDelta(nil, 0, 1))
+	New(t).False(mockAssertion.MeanInDelta([]any{1, "2"}, 0, 1))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).MeanInDelta([]int{2, 4}, 1, 1))
+	New(t).Contains(out.buf.String(), "Mean 3 is not within 1 of 1, difference is 2")
+	New(t).Contains(out.buf.String(), "(n=2 min=2 max=4 mean=3 stddev=1)")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).MeanInDelta([]any{1, "2"}, 0, 1))
+	New(t).Contains(out.buf.String(), `Sample [1] is not a number: "2"`)
+}
+
+func TestPercentileLE(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	latencies := []int{15, 20, 35, 40, 50}
+	New(t).True(mockAssertion.PercentileLE(latencies, 0.4, 20))
+	New(t).False(mockAssertion.PercentileLE(latencies, 0.41, 20))
+	New(t).True(mockAssertion.PercentileLE(latencies, 1, 50))
+	New(t).True(mockAssertion.PercentileLE(latencies, 0.01, 15))
+	New(t).False(mockAssertion.PercentileLE(latencies, 0, 100))
+	New(t).False(mockAssertion.PercentileLE(latencies, 1.5, 100))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).PercentileLE(latencies, 0.99, 45))
+	New(t).Contains(out.buf.String(), "p99 50 is greater than 45")
+	New(t).Contains(out.buf.String(), "n=5 min=15 max=50 mean=32")
+}
+
+func TestStdDevLE(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.StdDevLE([]float64{2, 4, 4, 4, 5, 5, 7, 9}, 2))
+	New(t).False(mockAssertion.StdDevLE([]float64{2, 4, 4, 4, 5, 5, 7, 9}, 1.9))
+	New(t).True(mockAssertion.StdDevLE([]int{3}, 0))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).StdDevLE([]int{1, 3}, 0.5))
+	New(t).Contains(out.buf.String(), "Standard deviation 1 is greater than 0.5")
+}
+
+func TestHistogramMatches(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	rolls := []int{1, 2, 3, 4, 5, 6, 1, 2, 3, 4, 5, 6}
+	dice := []float64{1, 2, 3, 4, 5, 6, 7}
+	fair := []float64{1, 1, 1, 1, 1, 1}
+	New(t).True(mockAssertion.HistogramMatches(rolls, dice, fair, 0))
+	New(t).True(mockAssertion.HistogramMatches([]float64{0, 0.5, 1}, []float64{0, 0.5, 1}, []float64{1, 2}, 0.001))
+	New(t).False(mockAssertion.HistogramMatches([]int{1, 1, 2}, dice, fair, 0.1))
+	New(t).False(mockAssertion.HistogramMatches(rolls, dice, fair[1:], 0.1))
+	New(t).False(mockAssertion.HistogramMatches(rolls, []float64{3, 1, 7}, []float64{1, 1}, 0.1))
+	New(t).False(mockAssertion.HistogramMatches(rolls, dice, make([]float64, 6), 0.1))
+	New(t).False(mockAssertion.HistogramMatches(rolls, []float64{1, 4, 5}, []float64{1, 1}, 1))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).HistogramMatches([]int{0, 0, 0, 1, 3}, []float64{0, 1, 2}, []float64{1, 1}, 0.1))
+	New(t).Contains(out.buf.String(), "5 sample(s) do not match the expected distribution within 0.1:")
+	New(t).Contains(out.buf.String(), "  [0, 1): 3 (0.6000), expected 0.5000")
+	New(t).Contains(out.buf.String(), "! [1, 2]: 1 (0.2000), expected 0.5000")
+	New(t).Contains(out.buf.String(), "! outside [0, 2]: 1")
+}
@@ -0,0 +1,341 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// structType returns the struct type of object, dereferencing pointers.
+// It returns nil if object is neither a struct nor a pointer to a struct.
+func structType(object any) reflect.Type {
+	t := reflect.TypeOf(object)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// HasStructTag asserts that the named field of the specified struct (or
+// pointer to struct) carries a tag with the given key and exactly the given
+// value.
+//
+//	a.HasStructTag(User{}, "FirstName", "json", "first_name,omitempty")
+func (a *Assertions) HasStructTag(object any, fieldName, key, value string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("HasStructTag", []any{object, fieldName, key, value, msgAndArgs}, func(a *Assertions) bool {
+			return a.HasStructTag(object, fieldName, key, value, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	t := structType(object)
+	if t == nil {
+		return a.Fail(fmt.Sprintf("%T is not a struct", object), msgAndArgs...)
+	}
+
+	field, ok := t.FieldByName(fieldName)
+	if !ok {
+		return a.Fail(fmt.Sprintf("%v has no field %q", t, fieldName), msgAndArgs...)
+	}
+
+	actual, ok := field.Tag.Lookup(key)
+	if !ok {
+		return a.Fail(fmt.Sprintf("Field %v.%s has no %q tag", t, fieldName, key), msgAndArgs...)
+	}
+	if actual != value {
+		return a.Fail(fmt.Sprintf("Field %v.%s has unexpected %q tag: \n"+
+			"expected: %q\n"+
+			"actual  : %q", t, fieldName, key, value, actual), msgAndArgs...)
+	}
+
+	return true
+}
+
+// AllFieldsTagged asserts that every exported field of the specified struct
+// (or pointer to struct) carries a tag with the given key.
+func (a *Assertions) AllFieldsTagged(object any, key string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("AllFieldsTagged", []any{object, key, msgAndArgs}, func(a *Assertions) bool {
+			return a.AllFieldsTagged(object, key, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	t := structType(object)
+	if t == nil {
+		return a.Fail(fmt.Sprintf("%T is not a struct", object), msgAndArgs...)
+	}
+
+	var untagged []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := field.Tag.Lookup(key); !ok {
+			untagged = append(untagged, field.Name)
+		}
+	}
+
+	if len(untagged) > 0 {
+		return a.Fail(fmt.Sprintf("Fields of %v without %q tag: %s", t, key, strings.Join(untagged, ", ")), msgAndArgs...)
+	}
+
+	return true
+}
+
+// splitFieldPath splits a dotted field path into its steps, so that
+// "Spec.Containers[0].Image" and "Spec.Containers.0.Image" both become
+// Spec, Containers, 0 and Image.
+func splitFieldPath(path string) []string {
+	var steps []string
+	for _, segment := range strings.Split(path, ".") {
+		for {
+			i := strings.IndexByte(segment, '[')
+			if i < 0 || !strings.HasSuffix(segment[i:], "]") {
+				break
+			}
+			if i > 0 {
+				steps = append(steps, segment[:i])
+			}
+			j := strings.IndexByte(segment[i:], ']') + i
+			steps = append(steps, segment[i+1:j])
+			segment = segment[j+1:]
+		}
+		if segment != "" {
+			steps = append(steps, segment)
+		}
+	}
+	return steps
+}
+
+// lookupField navigates object by a dotted path through exported struct
+// fields, map keys and slice or array indices, dereferencing pointers and
+// interfaces on the way.
+func lookupField(object any, path string) (reflect.Value, error) {
+	v := reflect.ValueOf(object)
+	var walked []string
+	for _, step := range splitFieldPath(path) {
+		for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+			if v.IsNil() {
+				return reflect.Value{}, fmt.Errorf("%s is nil", fieldPathOrRoot(walked))
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("%s is nil", fieldPathOrRoot(walked))
+		}
+
+		switch v.Kind() {
+		case reflect.Struct:
+			field, ok := v.Type().FieldByName(step)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%s (%v) has no field %q", fieldPathOrRoot(walked), v.Type(), step)
+			}
+			if !field.IsExported() {
+				return reflect.Value{}, fmt.Errorf("field %q of %s (%v) is unexported", step, fieldPathOrRoot(walked), v.Type())
+			}
+			v = v.FieldByIndex(field.Index)
+		case reflect.Map:
+			key, err := mapKey(v.Type().Key(), step)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%s (%v) cannot be indexed by %q: %v", fieldPathOrRoot(walked), v.Type(), step, err)
+			}
+			e := v.MapIndex(key)
+			if !e.IsValid() {
+				return reflect.Value{}, fmt.Errorf("%s (%v) has no key %q", fieldPathOrRoot(walked), v.Type(), step)
+			}
+			v = e
+		case reflect.Slice, reflect.Array:
+			i, err := strconv.Atoi(step)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%s (%v) cannot be indexed by %q", fieldPathOrRoot(walked), v.Type(), step)
+			}
+			if i < 0 || i >= v.Len() {
+				return reflect.Value{}, fmt.Errorf("index %d of %s is out of range [0, %d)", i, fieldPathOrRoot(walked), v.Len())
+			}
+			v = v.Index(i)
+		default:
+			return reflect.Value{}, fmt.Errorf("%s (%v) has no field %q", fieldPathOrRoot(walked), v.Type(), step)
+		}
+		walked = append(walked, step)
+	}
+	return v, nil
+}
+
+func fieldPathOrRoot(walked []string) string {
+	if len(walked) == 0 {
+		return "object"
+	}
+	return strings.Join(walked, ".")
+}
+
+// mapKey converts a path step to a key of the given map key type.
+func mapKey(t reflect.Type, step string) (reflect.Value, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(step).Convert(t), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(step, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(n).Convert(t), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(step, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(n).Convert(t), nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported key type %v", t)
+}
+
+// FieldEqual asserts that the value found in object at the given dotted
+// path is equal to expected. The path navigates exported struct fields, map
+// keys and slice or array indices.
+//
+//	a.FieldEqual(deployment, "Spec.Replicas", int32(3))
+//	a.FieldEqual(pod, "Spec.Containers[0].Image", "nginx:1.25")
+func (a *Assertions) FieldEqual(object any, path string, expected any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("FieldEqual", []any{object, path, expected, msgAndArgs}, func(a *Assertions) bool {
+			return a.FieldEqual(object, path, expected, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	v, err := lookupField(object, path)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Field path %q does not exist: %s", path, err), msgAndArgs...)
+	}
+
+	actual := v.Interface()
+	if !ObjectsAreEqual(expected, actual) {
+		diff := a.diff(expected, actual)
+		failing := a.withValues(expected, actual)
+		expected, actual := formatUnequalValues(expected, actual)
+		return failing.Fail(fmt.Sprintf("Field %s not equal: \n"+
+			"expected: %s\n"+
+			"actual  : %s%s", path, expected, actual, diff), msgAndArgs...)
+	}
+
+	return true
+}
+
+// FieldsNotZero asserts that the fields of object at the given dotted paths
+// are not zero values. Every zero-valued or missing field is reported.
+//
+//	a.FieldsNotZero(user, []string{"ID", "CreatedAt", "Owner.Email"})
+func (a *Assertions) FieldsNotZero(object any, paths []string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("FieldsNotZero", []any{object, paths, msgAndArgs}, func(a *Assertions) bool {
+			return a.FieldsNotZero(object, paths, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	var zero, missing []string
+	for _, path := range paths {
+		v, err := lookupField(object, path)
+		switch {
+		case err != nil:
+			missing = append(missing, fmt.Sprintf("%s: %s", path, err))
+		case v.IsZero():
+			zero = append(zero, path)
+		}
+	}
+
+	return a.failZeroFields(object, zero, missing, msgAndArgs...)
+}
+
+// AllExportedFieldsNotZero asserts that every exported field of the
+// specified struct (or pointer to struct) is not a zero value, and reports
+// every zero-valued field.
+func (a *Assertions) AllExportedFieldsNotZero(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("AllExportedFieldsNotZero", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.AllExportedFieldsNotZero(object, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	v := reflect.ValueOf(object)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return a.Fail(fmt.Sprintf("%T is not a struct", object), msgAndArgs...)
+	}
+
+	var zero []string
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).IsExported() && v.Field(i).IsZero() {
+			zero = append(zero, v.Type().Field(i).Name)
+		}
+	}
+
+	return a.failZeroFields(object, zero, nil, msgAndArgs...)
+}
+
+func (a *Assertions) failZeroFields(object any, zero, missing []string, msgAndArgs ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if len(zero) == 0 && len(missing) == 0 {
+		return true
+	}
+
+	var msg []string
+	if len(zero) > 0 {
+		msg = append(msg, fmt.Sprintf("Zero-valued fields of %T: %s", object, strings.Join(zero, ", ")))
+	}
+	if len(missing) > 0 {
+		msg = append(msg, fmt.Sprintf("Missing fields of %T:\n\t%s", object, strings.Join(missing, "\n\t")))
+	}
+	return a.Fail(strings.Join(msg, "\n"), msgAndArgs...)
+}
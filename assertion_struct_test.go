@@ -0,0 +1,174 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+type taggedStruct struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name,omitempty" yaml:"firstName"`
+	LastName  string `yaml:"lastName"`
+	internal  string
+}
+
+func TestHasStructTag(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.HasStructTag(taggedStruct{}, "ID", "json", "id"))
+	New(t).True(mockAssertion.HasStructTag(&taggedStruct{}, "FirstName", "json", "first_name,omitempty"))
+	New(t).True(mockAssertion.HasStructTag(taggedStruct{}, "FirstName", "yaml", "firstName"))
+	New(t).False(mockAssertion.HasStructTag(taggedStruct{}, "FirstName", "json", "first_name"))
+	New(t).False(mockAssertion.HasStructTag(taggedStruct{}, "LastName", "json", "last_name"))
+	New(t).False(mockAssertion.HasStructTag(taggedStruct{}, "Missing", "json", "missing"))
+	New(t).False(mockAssertion.HasStructTag(42, "ID", "json", "id"))
+	New(t).False(mockAssertion.HasStructTag(nil, "ID", "json", "id"))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).HasStructTag(taggedStruct{}, "ID", "json", "identifier"))
+	New(t).Contains(out.buf.String(), `expected: "identifier"`)
+	New(t).Contains(out.buf.String(), `actual  : "id"`)
+}
+
+func TestAllFieldsTagged(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).False(mockAssertion.AllFieldsTagged(taggedStruct{}, "yaml"))
+	New(t).True(mockAssertion.AllFieldsTagged(struct {
+		A int `json:"a"`
+		b int
+	}{}, "json"))
+	New(t).False(mockAssertion.AllFieldsTagged("struct", "json"))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).AllFieldsTagged(&taggedStruct{}, "yaml"))
+	New(t).Contains(out.buf.String(), `without "yaml" tag: ID`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).AllFieldsTagged(taggedStruct{}, "json"))
+	New(t).Contains(out.buf.String(), `without "json" tag: LastName`)
+}
+
+type fieldPathSpec struct {
+	Replicas   int32
+	Containers []struct {
+		Image string
+		Ports map[string]int
+	}
+	Limits map[int]string
+}
+
+type fieldPathObject struct {
+	Name  string
+	Spec  *fieldPathSpec
+	Extra any
+	owner string
+}
+
+func TestFieldEqual(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	spec := &fieldPathSpec{Replicas: 3, Limits: map[int]string{1: "low"}}
+	spec.Containers = append(spec.Containers, struct {
+		Image string
+		Ports map[string]int
+	}{Image: "nginx:1.25", Ports: map[string]int{"http": 80}})
+	obj := fieldPathObject{Name: "web", Spec: spec, Extra: map[string]any{"k": []any{"v"}}}
+
+	New(t).True(mockAssertion.FieldEqual(obj, "Name", "web"))
+	New(t).True(mockAssertion.FieldEqual(&obj, "Spec.Replicas", int32(3)))
+	New(t).True(mockAssertion.FieldEqual(obj, "Spec.Containers[0].Image", "nginx:1.25"))
+	New(t).True(mockAssertion.FieldEqual(obj, "Spec.Containers.0.Ports.http", 80))
+	New(t).True(mockAssertion.FieldEqual(obj, "Spec.Limits.1", "low"))
+	New(t).True(mockAssertion.FieldEqual(obj, "Extra.k[0]", "v"))
+	New(t).False(mockAssertion.FieldEqual(obj, "Spec.Replicas", 3), "int is not int32")
+	New(t).False(mockAssertion.FieldEqual(obj, "owner", ""))
+	New(t).False(mockAssertion.FieldEqual(fieldPathObject{}, "Spec.Replicas", int32(0)))
+
+	cases := []struct {
+		path    string
+		message string
+	}{
+		{"Spec.Replica", `Spec (assert.fieldPathSpec) has no field "Replica"`},
+		{"Spec.Containers[1].Image", "index 1 of Spec.Containers is out of range [0, 1)"},
+		{"Spec.Containers.first", `Spec.Containers ([]struct { Image string; Ports map[string]int }) cannot be indexed by "first"`},
+		{"Spec.Containers[0].Ports.https", `Spec.Containers.0.Ports (map[string]int) has no key "https"`},
+		{"Spec.Limits.high", `Spec.Limits (map[int]string) cannot be indexed by "high"`},
+		{"Name.Length", `Name (string) has no field "Length"`},
+		{"owner", `field "owner" of object (assert.fieldPathObject) is unexported`},
+	}
+	for _, c := range cases {
+		out := &outputT{buf: bytes.NewBuffer(nil)}
+		New(t).False(NewWithOnFailureNoop(out).FieldEqual(obj, c.path, nil))
+		New(t).Contains(out.buf.String(), `Field path "`+c.path+`" does not exist: `+c.message)
+	}
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).FieldEqual(fieldPathObject{}, "Spec.Replicas", int32(0)))
+	New(t).Contains(out.buf.String(), "Spec is nil")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).FieldEqual(obj, "Spec.Replicas", int32(5)))
+	New(t).Contains(out.buf.String(), "Field Spec.Replicas not equal:")
+	New(t).Contains(out.buf.String(), "expected: 5")
+	New(t).Contains(out.buf.String(), "actual  : 3")
+}
+
+type zeroFieldsOwner struct {
+	Email string
+}
+
+type zeroFieldsObject struct {
+	ID        int
+	CreatedAt time.Time
+	Owner     *zeroFieldsOwner
+	Tags      []string
+	internal  string
+}
+
+func TestFieldsNotZero(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	full := zeroFieldsObject{ID: 1, CreatedAt: time.Unix(1, 0), Owner: &zeroFieldsOwner{Email: "a@b.c"}, Tags: []string{}}
+	New(t).True(mockAssertion.FieldsNotZero(full, []string{"ID", "CreatedAt", "Owner.Email", "Tags"}))
+	New(t).True(mockAssertion.FieldsNotZero(&full, nil))
+	New(t).False(mockAssertion.FieldsNotZero(zeroFieldsObject{ID: 1}, []string{"ID", "CreatedAt"}))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).FieldsNotZero(zeroFieldsObject{Owner: &zeroFieldsOwner{}}, []string{"ID", "CreatedAt", "Owner.Email", "Owner.Name"}, "fixture %d", 1))
+	New(t).Contains(out.buf.String(), "Zero-valued fields of assert.zeroFieldsObject: ID, CreatedAt, Owner.Email")
+	New(t).Contains(out.buf.String(), `Owner.Name: Owner (assert.zeroFieldsOwner) has no field "Name"`)
+	New(t).Contains(out.buf.String(), "fixture 1")
+}
+
+func TestAllExportedFieldsNotZero(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	full := zeroFieldsObject{ID: 1, CreatedAt: time.Unix(1, 0), Owner: &zeroFieldsOwner{}, Tags: []string{}}
+	New(t).True(mockAssertion.AllExportedFieldsNotZero(full))
+	New(t).True(mockAssertion.AllExportedFieldsNotZero(&full))
+	New(t).False(mockAssertion.AllExportedFieldsNotZero(42))
+	New(t).False(mockAssertion.AllExportedFieldsNotZero((*zeroFieldsObject)(nil)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).AllExportedFieldsNotZero(&zeroFieldsObject{ID: 1}, "fixture"))
+	New(t).Contains(out.buf.String(), "Zero-valued fields of *assert.zeroFieldsObject: CreatedAt, Owner, Tags")
+	New(t).Contains(out.buf.String(), "fixture")
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ValueAssertionFunc is a common function prototype when validating a single
+// value, e.g. (*Assertions).NotNil. It can be used for table driven tests.
+type ValueAssertionFunc func(a *Assertions, value any, msgAndArgs ...any) bool
+
+// BoolAssertionFunc is a common function prototype when validating a bool
+// value, e.g. (*Assertions).True. It can be used for table driven tests.
+type BoolAssertionFunc func(a *Assertions, value bool, msgAndArgs ...any) bool
+
+// ComparisonAssertionFunc is a common function prototype when comparing two
+// values, e.g. (*Assertions).Equal. It can be used for table driven tests.
+type ComparisonAssertionFunc func(a *Assertions, expected, actual any, msgAndArgs ...any) bool
+
+// ErrorAssertionFunc is a common function prototype when validating an
+// error value, e.g. (*Assertions).NoError. It can be used for table driven
+// tests.
+type ErrorAssertionFunc func(a *Assertions, err error, msgAndArgs ...any) bool
+
+// RunTable runs body for each of the cases. When the TestingT of a supports
+// subtests, every case runs in its own subtest. The Assertions passed to
+// body reports through the subtest and labels its failures with the case
+// index and name. The name of a case is taken from its Name field when the
+// case is a struct with such a string field.
+//
+// Expected outcomes are best described with the assertion func types:
+//
+//	type testCase struct {
+//		Name    string
+//		Input   string
+//		WantErr assert.ErrorAssertionFunc
+//	}
+//
+//	assert.RunTable(assert.New(t), []testCase{
+//		{"valid", "42", (*assert.Assertions).NoError},
+//		{"invalid", "x", (*assert.Assertions).Error},
+//	}, func(t assert.TestingT, a *assert.Assertions, c testCase) {
+//		_, err := strconv.Atoi(c.Input)
+//		c.WantErr(a, err)
+//	})
+func RunTable[C any](a *Assertions, cases []C, body func(t TestingT, a *Assertions, c C)) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	for i, c := range cases {
+		name := tableCaseName(i, c)
+		label := fmt.Sprintf("#%d %s", i, name)
+		c := c
+		if !runSubtest(a.t, name, func(t TestingT) {
+			body(t, a.withT(t).withLabel("Case", label), c)
+		}) {
+			body(a.t, a.withLabel("Case", label), c)
+		}
+	}
+}
+
+// runSubtest runs f in a subtest of t if t has a Run method like
+// (*testing.T).Run, and reports whether it did. The method is resolved by
+// reflection, which keeps the testing package out of non-test binaries.
+func runSubtest(t TestingT, name string, f func(t TestingT)) bool {
+	run := reflect.ValueOf(t).MethodByName("Run")
+	if !run.IsValid() {
+		return false
+	}
+	runType := run.Type()
+	if runType.NumIn() != 2 || runType.In(0) != reflect.TypeOf(name) ||
+		runType.NumOut() != 1 || runType.Out(0).Kind() != reflect.Bool {
+		return false
+	}
+	subtestType := runType.In(1)
+	if subtestType.Kind() != reflect.Func || subtestType.NumIn() != 1 || subtestType.NumOut() != 0 ||
+		!subtestType.In(0).Implements(reflect.TypeOf((*TestingT)(nil)).Elem()) {
+		return false
+	}
+
+	run.Call([]reflect.Value{reflect.ValueOf(name), reflect.MakeFunc(subtestType, func(args []reflect.Value) []reflect.Value {
+		f(args[0].Interface().(TestingT))
+		return nil
+	})})
+	return true
+}
+
+// tableCaseName returns the Name field of c if it has one, or a name derived
+// from its index otherwise.
+func tableCaseName(i int, c any) string {
+	v := reflect.ValueOf(c)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if f := v.FieldByName("Name"); f.IsValid() && f.Kind() == reflect.String && f.String() != "" {
+			return f.String()
+		}
+	}
+	return fmt.Sprintf("case #%d", i)
+}
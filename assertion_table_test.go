@@ -0,0 +1,102 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func TestAssertionFuncTypes(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	var value ValueAssertionFunc = (*Assertions).NotNil
+	var boolean BoolAssertionFunc = (*Assertions).True
+	var comparison ComparisonAssertionFunc = (*Assertions).Equal
+	var err ErrorAssertionFunc = (*Assertions).NoError
+
+	New(t).True(value(mockAssertion, 1))
+	New(t).True(boolean(mockAssertion, true))
+	New(t).True(comparison(mockAssertion, 1, 1))
+	New(t).True(err(mockAssertion, nil))
+	New(t).False(err(mockAssertion, strconv.ErrSyntax))
+}
+
+func TestRunTable(t *testing.T) {
+	type testCase struct {
+		Name    string
+		Input   string
+		Want    int
+		WantErr ErrorAssertionFunc
+	}
+
+	var names []string
+	RunTable(New(t), []testCase{
+		{"valid", "42", 42, (*Assertions).NoError},
+		{"invalid", "x", 0, (*Assertions).Error},
+		{"", "7", 7, (*Assertions).NoError},
+	}, func(t TestingT, a *Assertions, c testCase) {
+		names = append(names, t.(*testing.T).Name())
+		v, err := strconv.Atoi(c.Input)
+		c.WantErr(a, err)
+		a.Equal(c.Want, v)
+	})
+	New(t).Equal([]string{"TestRunTable/valid", "TestRunTable/invalid", "TestRunTable/case_#2"}, names)
+}
+
+func TestRunTableLabelsFailures(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	var seen []TestingT
+	RunTable(NewWithOnFailureNoop(out), []struct {
+		Name string
+		Want bool
+	}{{"pass", true}, {"fail", false}}, func(t TestingT, a *Assertions, c struct {
+		Name string
+		Want bool
+	}) {
+		seen = append(seen, t)
+		a.True(c.Want)
+	})
+	New(t).Equal([]TestingT{out, out}, seen)
+	New(t).Contains(out.buf.String(), "Case:")
+	New(t).Contains(out.buf.String(), "#1 fail")
+	New(t).NotContains(out.buf.String(), "#0 pass")
+}
+
+// runnerT is an outputT with subtests, like *testing.T.
+type runnerT struct {
+	outputT
+	names []string
+}
+
+func (t *runnerT) Run(name string, f func(t *runnerT)) bool {
+	t.names = append(t.names, name)
+	f(t)
+	return true
+}
+
+func TestRunTableSubtests(t *testing.T) {
+	out := &runnerT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	var seen []TestingT
+	RunTable(NewWithOnFailureNoop(out), []struct{ Name string }{{"first"}, {"second"}}, func(t TestingT, a *Assertions, c struct{ Name string }) {
+		seen = append(seen, t)
+	})
+	New(t).Equal([]string{"first", "second"}, out.names)
+	New(t).Len(seen, 2)
+	New(t).Same(out, seen[0])
+}
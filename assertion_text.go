@@ -0,0 +1,113 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lineEndingsReplacer rewrites CRLF and lone CR line endings to LF.
+var lineEndingsReplacer = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+// normalizeLineEndings returns s with all line endings converted to LF.
+func normalizeLineEndings(s string) string {
+	return lineEndingsReplacer.Replace(s)
+}
+
+// lineEndingsNote returns a note for failure messages when expected and
+// actual are strings that differ only in their line endings.
+func lineEndingsNote(expected, actual any) string {
+	e, ok := expected.(string)
+	if !ok {
+		return ""
+	}
+	x, ok := actual.(string)
+	if !ok || e == x || normalizeLineEndings(e) != normalizeLineEndings(x) {
+		return ""
+	}
+	return "\n\nThe strings differ only in line endings (CRLF vs LF)."
+}
+
+// EqualIgnoringLineEndings asserts that two strings are equal once CRLF and
+// CR line endings are normalized to LF, which keeps golden text comparisons
+// stable across platforms.
+//
+//	a.EqualIgnoringLineEndings(string(golden), out.String())
+func (a *Assertions) EqualIgnoringLineEndings(expected, actual string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EqualIgnoringLineEndings", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.EqualIgnoringLineEndings(expected, actual, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	e, x := normalizeLineEndings(expected), normalizeLineEndings(actual)
+	if e != x {
+		diff := a.diff(e, x)
+		return a.Fail(fmt.Sprintf("Not equal (ignoring line endings): \n"+
+			"expected: %q\n"+
+			"actual  : %q%s", e, x, diff), msgAndArgs...)
+	}
+
+	return true
+}
+
+// Blank asserts that s is empty or consists of whitespace only. Unlike
+// Empty, it accepts strings like "  \n". The string is quoted in failure
+// messages, so that whitespace is visible.
+func (a *Assertions) Blank(s string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Blank", []any{s, msgAndArgs}, func(a *Assertions) bool {
+			return a.Blank(s, msgAndArgs...)
+		})
+	}
+	if strings.TrimSpace(s) != "" {
+		if h, ok := a.t.(tHelper); ok {
+			h.Helper()
+		}
+		return a.Fail(fmt.Sprintf("Should be blank, but was %q", s), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotBlank asserts that s contains at least one non-whitespace character.
+func (a *Assertions) NotBlank(s string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotBlank", []any{s, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotBlank(s, msgAndArgs...)
+		})
+	}
+	if strings.TrimSpace(s) == "" {
+		if h, ok := a.t.(tHelper); ok {
+			h.Helper()
+		}
+		return a.Fail(fmt.Sprintf("Should not be blank, but was %q", s), msgAndArgs...)
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEqualIgnoringLineEndings(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.EqualIgnoringLineEndings("a\nb\n", "a\r\nb\r\n"))
+	New(t).True(mockAssertion.EqualIgnoringLineEndings("a\nb", "a\rb"))
+	New(t).True(mockAssertion.EqualIgnoringLineEndings("", ""))
+	New(t).False(mockAssertion.EqualIgnoringLineEndings("a\nb", "a\n\nb"))
+	New(t).False(mockAssertion.EqualIgnoringLineEndings("a\r\nb", "a\r\nc"))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).EqualIgnoringLineEndings("x\r\ny\r\n", "x\nz\n"))
+	New(t).Contains(out.buf.String(), "Not equal (ignoring line endings):")
+	New(t).Contains(out.buf.String(), "expected: \"x\\ny\\n\"")
+	New(t).Contains(out.buf.String(), "-y")
+	New(t).Contains(out.buf.String(), "+z")
+}
+
+func TestEqualNotesLineEndings(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Equal("a\nb", "a\r\nb"))
+	New(t).Contains(out.buf.String(), "The strings differ only in line endings (CRLF vs LF).")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Equal("a\nb", "a\r\nc"))
+	New(t).NotContains(out.buf.String(), "line endings")
+}
+
+func TestBlank(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	for _, s := range []string{"", " ", "\t\r\n", "  "} {
+		New(t).True(mockAssertion.Blank(s), "%q", s)
+		New(t).False(mockAssertion.NotBlank(s), "%q", s)
+	}
+	for _, s := range []string{"a", "  a  ", "\n-"} {
+		New(t).False(mockAssertion.Blank(s), "%q", s)
+		New(t).True(mockAssertion.NotBlank(s), "%q", s)
+	}
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NotBlank(" \t\n"))
+	New(t).Contains(out.buf.String(), `Should not be blank, but was " \t\n"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Blank("\tx"))
+	New(t).Contains(out.buf.String(), `Should be blank, but was "\tx"`)
+}
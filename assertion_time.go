@@ -0,0 +1,162 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timeOrder describes how far t1 is from t2 for Before and After failures.
+func timeOrder(t1, t2 time.Time) string {
+	switch {
+	case t1.Equal(t2):
+		return "they are equal"
+	case t1.After(t2):
+		return fmt.Sprintf("it is after by %v", t1.Sub(t2))
+	default:
+		return fmt.Sprintf("it is before by %v", t2.Sub(t1))
+	}
+}
+
+// Before asserts that t1 is before t2, in the sense of time.Time.Before.
+//
+//	a.Before(order.CreatedAt, order.ShippedAt)
+func (a *Assertions) Before(t1, t2 time.Time, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Before", []any{t1, t2, msgAndArgs}, func(a *Assertions) bool {
+			return a.Before(t1, t2, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !t1.Before(t2) {
+		return a.Fail(fmt.Sprintf("\"%s\" is not before \"%s\": %s", t1.Format(time.RFC3339Nano), t2.Format(time.RFC3339Nano), timeOrder(t1, t2)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// After asserts that t1 is after t2, in the sense of time.Time.After.
+//
+//	a.After(token.ExpiresAt, time.Now())
+func (a *Assertions) After(t1, t2 time.Time, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("After", []any{t1, t2, msgAndArgs}, func(a *Assertions) bool {
+			return a.After(t1, t2, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !t1.After(t2) {
+		return a.Fail(fmt.Sprintf("\"%s\" is not after \"%s\": %s", t1.Format(time.RFC3339Nano), t2.Format(time.RFC3339Nano), timeOrder(t1, t2)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// sampleSeries calls getter n times, interval apart, starting right away.
+func (a *Assertions) sampleSeries(getter func() float64, n int, interval time.Duration) []float64 {
+	series := make([]float64, 0, n)
+	if n <= 0 {
+		return series
+	}
+	ticker := a.clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	series = append(series, getter())
+	for len(series) < n {
+		<-ticker.C()
+		series = append(series, getter())
+	}
+	return series
+}
+
+// checkProgress samples getter and fails at the first sample that does not
+// increase, or does not decrease when nonStrict is set, over the previous.
+func (a *Assertions) checkProgress(getter func() float64, samples int, interval time.Duration, nonStrict bool, msgAndArgs ...any) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	series := a.sampleSeries(getter, samples, interval)
+	for i := 1; i < len(series); i++ {
+		if series[i] > series[i-1] || nonStrict && series[i] == series[i-1] {
+			continue
+		}
+		expectation := "increase"
+		if nonStrict {
+			expectation = "not decrease"
+		}
+		formatted := make([]string, len(series))
+		for j, v := range series {
+			formatted[j] = fmt.Sprint(v)
+		}
+		return a.Fail(fmt.Sprintf("Samples should %s, but sample %d (%v) follows %v\nseries (every %v): [%s]",
+			expectation, i, series[i], series[i-1], interval, strings.Join(formatted, " ")), msgAndArgs...)
+	}
+	return true
+}
+
+// EventuallyIncreasing samples getter the given number of times, interval
+// apart, and asserts that every sample is greater than the previous one.
+// The recorded series is reported on failure. It suits progress and
+// throughput checks.
+//
+//	a.EventuallyIncreasing(func() float64 {
+//		return float64(queue.Processed())
+//	}, 5, 100*time.Millisecond)
+func (a *Assertions) EventuallyIncreasing(getter func() float64, samples int, interval time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EventuallyIncreasing", []any{getter, samples, interval, msgAndArgs}, func(a *Assertions) bool {
+			return a.EventuallyIncreasing(getter, samples, interval, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.checkProgress(getter, samples, interval, false, msgAndArgs...)
+}
+
+// EventuallyNonDecreasing is like EventuallyIncreasing, but also accepts
+// samples that equal the previous one.
+func (a *Assertions) EventuallyNonDecreasing(getter func() float64, samples int, interval time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EventuallyNonDecreasing", []any{getter, samples, interval, msgAndArgs}, func(a *Assertions) bool {
+			return a.EventuallyNonDecreasing(getter, samples, interval, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.checkProgress(getter, samples, interval, true, msgAndArgs...)
+}
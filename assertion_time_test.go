@@ -0,0 +1,87 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestBefore(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	t0 := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	New(t).True(mockAssertion.Before(t0, t0.Add(time.Nanosecond)))
+	New(t).False(mockAssertion.Before(t0, t0))
+	New(t).False(mockAssertion.Before(t0.Add(time.Second), t0))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Before(t0.Add(90*time.Second), t0))
+	New(t).Contains(out.buf.String(), `"2022-01-02T03:05:35Z" is not before "2022-01-02T03:04:05Z": it is after by 1m30s`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Before(t0, t0.In(time.FixedZone("CET", 3600))))
+	New(t).Contains(out.buf.String(), "they are equal")
+}
+
+func TestAfter(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	t0 := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	New(t).True(mockAssertion.After(t0.Add(time.Nanosecond), t0))
+	New(t).False(mockAssertion.After(t0, t0))
+	New(t).False(mockAssertion.After(t0, t0.Add(time.Second)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).After(t0, t0.Add(1500*time.Millisecond)))
+	New(t).Contains(out.buf.String(), `"2022-01-02T03:04:05Z" is not after "2022-01-02T03:04:06.5Z": it is before by 1.5s`)
+}
+
+func sequence(values ...float64) func() float64 {
+	i := 0
+	return func() float64 {
+		v := values[i]
+		i++
+		return v
+	}
+}
+
+func TestEventuallyIncreasing(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.EventuallyIncreasing(sequence(1, 2, 5), 3, time.Millisecond))
+	New(t).True(mockAssertion.EventuallyIncreasing(sequence(1), 1, time.Millisecond))
+	New(t).False(mockAssertion.EventuallyIncreasing(sequence(1, 1, 2), 3, time.Millisecond))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).EventuallyIncreasing(sequence(1, 3, 2, 4), 4, time.Millisecond, "throughput"))
+	New(t).Contains(out.buf.String(), "Samples should increase, but sample 2 (2) follows 3")
+	New(t).Contains(out.buf.String(), "series (every 1ms): [1 3 2 4]")
+	New(t).Contains(out.buf.String(), "throughput")
+}
+
+func TestEventuallyNonDecreasing(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.EventuallyNonDecreasing(sequence(1, 1, 2), 3, time.Millisecond))
+	New(t).False(mockAssertion.EventuallyNonDecreasing(sequence(2, 1), 2, time.Millisecond))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).EventuallyNonDecreasing(sequence(0.5, 0.25), 2, time.Millisecond))
+	New(t).Contains(out.buf.String(), "Samples should not decrease, but sample 1 (0.25) follows 0.5")
+}
@@ -0,0 +1,288 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLOption configures how YAMLEq compares documents; see WithYAMLOptions.
+type YAMLOption func(*yamlConfig)
+
+type yamlConfig struct {
+	keepAliases        bool
+	numericEquivalence bool
+	allowDuplicateKeys bool
+	// documentKeys identify documents of a stream when they are compared
+	// regardless of order; nil means documents are compared in order.
+	documentKeys [][]string
+}
+
+// YAMLKeepAliases makes YAMLEq compare aliases by anchor name instead of
+// expanding them to the anchored content, so that both documents must share
+// the same anchor structure.
+func YAMLKeepAliases() YAMLOption {
+	return func(c *yamlConfig) {
+		c.keepAliases = true
+	}
+}
+
+// YAMLNumericEquivalence makes YAMLEq compare numbers by value, so that 1 and
+// 1.0 are equal.
+func YAMLNumericEquivalence() YAMLOption {
+	return func(c *yamlConfig) {
+		c.numericEquivalence = true
+	}
+}
+
+// YAMLAllowDuplicateKeys makes YAMLEq accept mappings with duplicated keys,
+// where the last value wins. By default duplicated keys are an error.
+func YAMLAllowDuplicateKeys() YAMLOption {
+	return func(c *yamlConfig) {
+		c.allowDuplicateKeys = true
+	}
+}
+
+// YAMLUnorderedDocuments makes YAMLEq match the documents of multi-document
+// streams by the values of the given fields instead of by position. Nested
+// fields are addressed with dots, and the fields default to "kind" and
+// "metadata.name", which identify Kubernetes manifests.
+//
+//	a.WithYAMLOptions(assert.YAMLUnorderedDocuments()).YAMLEq(expected, rendered)
+func YAMLUnorderedDocuments(fields ...string) YAMLOption {
+	if len(fields) == 0 {
+		fields = []string{"kind", "metadata.name"}
+	}
+	return func(c *yamlConfig) {
+		c.documentKeys = nil
+		for _, field := range fields {
+			c.documentKeys = append(c.documentKeys, strings.Split(field, "."))
+		}
+	}
+}
+
+// WithYAMLOptions returns a new Assertions whose YAMLEq compares documents
+// as configured by opts, in addition to the options already set.
+//
+//	a.WithYAMLOptions(assert.YAMLNumericEquivalence()).YAMLEq(expected, actual, "rendered chart")
+func (a *Assertions) WithYAMLOptions(opts ...YAMLOption) *Assertions {
+	c := *a
+	for _, opt := range opts {
+		opt(&c.yamlConfig)
+	}
+	return &c
+}
+
+// yamlAlias is an unexpanded alias, compared by the name of its anchor.
+type yamlAlias string
+
+func (alias yamlAlias) MarshalJSON() ([]byte, error) {
+	return json.Marshal("*" + string(alias))
+}
+
+// parse decodes every document of a YAML stream into plain Go values:
+// mappings become map[string]any keyed by the scalar text of their keys, and
+// sequences become []any.
+func (c yamlConfig) parse(s string) ([]any, error) {
+	var documents []any
+	decoder := yaml.NewDecoder(strings.NewReader(s))
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err == io.EOF {
+			return documents, nil
+		} else if err != nil {
+			return nil, err
+		}
+		document, err := c.convert(&node, map[*yaml.Node]bool{})
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+}
+
+// diff lists the differences between two YAML streams as JSON paths. A
+// path is prefixed with its document when the streams are not single
+// documents.
+func (c yamlConfig) diff(expected, actual []any) []string {
+	if len(expected) == 1 && len(actual) == 1 {
+		return jsonPathDiff("$", expected[0], actual[0])
+	}
+	if c.documentKeys != nil {
+		return c.unorderedDocumentsDiff(expected, actual)
+	}
+
+	var differences []string
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		switch {
+		case i >= len(actual):
+			differences = append(differences, fmt.Sprintf("document %d: missing", i+1))
+		case i >= len(expected):
+			differences = append(differences, fmt.Sprintf("document %d: unexpected", i+1))
+		default:
+			for _, d := range jsonPathDiff("$", expected[i], actual[i]) {
+				differences = append(differences, fmt.Sprintf("document %d: %s", i+1, d))
+			}
+		}
+	}
+	return differences
+}
+
+func (c yamlConfig) unorderedDocumentsDiff(expected, actual []any) []string {
+	candidates := make(map[string][]int)
+	for j, document := range actual {
+		key := c.documentKey(document)
+		candidates[key] = append(candidates[key], j)
+	}
+
+	var differences []string
+	matched := make([]bool, len(actual))
+	for _, document := range expected {
+		key := c.documentKey(document)
+		if len(candidates[key]) == 0 {
+			differences = append(differences, fmt.Sprintf("document %s: missing", key))
+			continue
+		}
+		j := candidates[key][0]
+		candidates[key] = candidates[key][1:]
+		matched[j] = true
+		for _, d := range jsonPathDiff("$", document, actual[j]) {
+			differences = append(differences, fmt.Sprintf("document %s: %s", key, d))
+		}
+	}
+	for j, document := range actual {
+		if !matched[j] {
+			differences = append(differences, fmt.Sprintf("document %s: unexpected", c.documentKey(document)))
+		}
+	}
+	return differences
+}
+
+// documentKey renders the identifying fields of a document, e.g.
+// "(kind=Service, metadata.name=web)".
+func (c yamlConfig) documentKey(document any) string {
+	parts := make([]string, 0, len(c.documentKeys))
+	for _, path := range c.documentKeys {
+		var v any = document
+		for _, field := range path {
+			m, ok := v.(map[string]any)
+			if !ok {
+				v = nil
+				break
+			}
+			v = m[field]
+		}
+		parts = append(parts, fmt.Sprintf("%s=%v", strings.Join(path, "."), v))
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func (c yamlConfig) convert(n *yaml.Node, visiting map[*yaml.Node]bool) (any, error) {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil, nil
+		}
+		return c.convert(n.Content[0], visiting)
+	case yaml.AliasNode:
+		if c.keepAliases {
+			return yamlAlias(n.Value), nil
+		}
+		if visiting[n.Alias] {
+			return nil, fmt.Errorf("line %d: alias *%s refers to itself", n.Line, n.Value)
+		}
+		visiting[n.Alias] = true
+		defer delete(visiting, n.Alias)
+		return c.convert(n.Alias, visiting)
+	case yaml.ScalarNode:
+		var v any
+		if err := n.Decode(&v); err != nil {
+			return nil, err
+		}
+		if c.numericEquivalence {
+			v = toFloat64IfNumber(v)
+		}
+		return v, nil
+	case yaml.SequenceNode:
+		s := make([]any, 0, len(n.Content))
+		for _, item := range n.Content {
+			v, err := c.convert(item, visiting)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+		}
+		return s, nil
+	case yaml.MappingNode:
+		return c.convertMapping(n, visiting)
+	}
+	return nil, nil
+}
+
+func (c yamlConfig) convertMapping(n *yaml.Node, visiting map[*yaml.Node]bool) (any, error) {
+	m := make(map[string]any, len(n.Content)/2)
+	var merges []any
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, value := n.Content[i], n.Content[i+1]
+		v, err := c.convert(value, visiting)
+		if err != nil {
+			return nil, err
+		}
+		if key.Tag == "!!merge" && !c.keepAliases {
+			if s, ok := v.([]any); ok {
+				merges = append(merges, s...)
+			} else {
+				merges = append(merges, v)
+			}
+			continue
+		}
+		if _, ok := m[key.Value]; ok && !c.allowDuplicateKeys {
+			return nil, fmt.Errorf("line %d: mapping key %q already defined", key.Line, key.Value)
+		}
+		m[key.Value] = v
+	}
+
+	// Explicit keys take precedence over merged ones, and earlier merged
+	// mappings over later ones.
+	for _, merge := range merges {
+		mm, ok := merge.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("line %d: map merge requires a mapping or a list of mappings", n.Line)
+		}
+		for k, v := range mm {
+			if _, ok := m[k]; !ok {
+				m[k] = v
+			}
+		}
+	}
+	return m, nil
+}
+
+func toFloat64IfNumber(v any) any {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case uint64:
+		return float64(n)
+	}
+	return v
+}
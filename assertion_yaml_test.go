@@ -0,0 +1,90 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestYAMLEqOptions(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).False(mockAssertion.YAMLEq("replicas: 1", "replicas: 1.0"))
+	New(t).True(mockAssertion.WithYAMLOptions(YAMLNumericEquivalence()).YAMLEq("replicas: 1", "replicas: 1.0"))
+	New(t).False(mockAssertion.WithYAMLOptions(YAMLNumericEquivalence()).YAMLEq("replicas: 1", "replicas: 2"))
+
+	duplicated := "a: 1\na: 2\n"
+	New(t).False(mockAssertion.YAMLEq("a: 2", duplicated))
+	New(t).True(mockAssertion.WithYAMLOptions(YAMLAllowDuplicateKeys()).YAMLEq("a: 2", duplicated))
+	New(t).True(mockAssertion.WithYAMLOptions(YAMLAllowDuplicateKeys()).WithYAMLOptions(YAMLNumericEquivalence()).YAMLEq("a: 2.0", duplicated))
+
+	anchored := "base: &base {x: 1}\nderived: *base\n"
+	expanded := "base: {x: 1}\nderived: {x: 1}\n"
+	New(t).True(mockAssertion.YAMLEq(expanded, anchored))
+	New(t).False(mockAssertion.WithYAMLOptions(YAMLKeepAliases()).YAMLEq(expanded, anchored))
+	New(t).True(mockAssertion.WithYAMLOptions(YAMLKeepAliases()).YAMLEq("base: &b {x: 1}\nderived: *b\n", "base: &b {x: 1}\nderived: *b\n"))
+
+	merged := "base: &base {x: 1, y: 2}\nderived:\n  <<: *base\n  y: 3\n"
+	New(t).True(mockAssertion.YAMLEq("base: {x: 1, y: 2}\nderived: {x: 1, y: 3}\n", merged))
+	New(t).True(mockAssertion.YAMLEq("", ""))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).YAMLEq("a: 1", duplicated, "rendered %s", "chart"))
+	New(t).Contains(out.buf.String(), `line 2: mapping key "a" already defined`)
+	New(t).Contains(out.buf.String(), "rendered chart")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).YAMLEq(
+		"spec:\n  containers:\n    - name: app\n      image: app:1\n",
+		"spec:\n  containers:\n    - name: app\n      image: app:2\n"))
+	New(t).Contains(out.buf.String(), `$.spec.containers[0].image: "app:1" != "app:2"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithYAMLOptions(YAMLKeepAliases()).YAMLEq("x: &x 1\na: *x", "x: &x 1\na: 1"))
+	New(t).Contains(out.buf.String(), `$.a: "*x" != 1`)
+}
+
+func TestYAMLEqMultiDocument(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	service := "kind: Service\nmetadata:\n  name: web\nspec:\n  port: 80\n"
+	deployment := "kind: Deployment\nmetadata:\n  name: web\nspec:\n  replicas: 2\n"
+	bundle := service + "---\n" + deployment
+
+	New(t).True(mockAssertion.YAMLEq(bundle, bundle))
+	New(t).False(mockAssertion.YAMLEq(bundle, deployment+"---\n"+service))
+	New(t).True(mockAssertion.WithYAMLOptions(YAMLUnorderedDocuments()).YAMLEq(bundle, deployment+"---\n"+service))
+	New(t).False(mockAssertion.YAMLEq(bundle, service))
+	New(t).False(mockAssertion.WithYAMLOptions(YAMLUnorderedDocuments()).YAMLEq(bundle, service))
+	New(t).True(mockAssertion.WithYAMLOptions(YAMLUnorderedDocuments("id")).YAMLEq("id: 1\n---\nid: 2\n", "id: 2\n---\nid: 1\n"))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).YAMLEq(bundle, service+"---\n"+deployment+"---\nkind: ConfigMap\n"))
+	New(t).Contains(out.buf.String(), "document 3: unexpected")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).YAMLEq(bundle, deployment+"---\n"+service, "manifests"))
+	New(t).Contains(out.buf.String(), `document 1: $.kind: "Service" != "Deployment"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithYAMLOptions(YAMLUnorderedDocuments()).YAMLEq(bundle, "kind: ConfigMap\nmetadata:\n  name: web\n---\n"+
+		"kind: Deployment\nmetadata:\n  name: web\nspec:\n  replicas: 3\n"))
+	New(t).Contains(out.buf.String(), "document (kind=Service, metadata.name=web): missing")
+	New(t).Contains(out.buf.String(), "document (kind=Deployment, metadata.name=web): $.spec.replicas: 2 != 3")
+	New(t).Contains(out.buf.String(), "document (kind=ConfigMap, metadata.name=web): unexpected")
+}
@@ -14,6 +14,8 @@
 
 package assert
 
+//go:generate go run gen.go
+
 import (
 	"bufio"
 	"bytes"
@@ -24,7 +26,6 @@ import (
 	"os"
 	"reflect"
 	"regexp"
-	"runtime"
 	"runtime/debug"
 	"strings"
 	"time"
@@ -32,32 +33,179 @@ import (
 	"unicode/utf8"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/pmezard/go-difflib/difflib"
-	"gopkg.in/yaml.v3"
 )
 
 // Assertions provides assertion methods around the TestingT interface.
 type Assertions struct {
 	t         TestingT
 	onFailure func(TestingT)
-}
-
-// New makes a new Assertions object for the specified TestingT.
-func New(t TestingT) *Assertions {
-	return &Assertions{
+	clock     Clock
+	// onFailureDetail replaces onFailure if set; see WithOnFailureDetail.
+	onFailureDetail func(TestingT, Failure)
+	// jsonPatch makes structural assertions append an RFC 6902 JSON Patch
+	// to their failure messages.
+	jsonPatch bool
+	// lineWidth and plainLayout control how failure messages are rendered.
+	lineWidth   int
+	plainLayout bool
+	// quiet suppresses formatting and reporting of failures.
+	quiet bool
+	// diffEngine renders differences in failure messages; nil means the
+	// engine set with SetDiffEngine.
+	diffEngine DiffEngine
+	// deferred buffers failures to report them grouped at the end of the
+	// test; nil means failures are reported immediately.
+	deferred *deferredFailures
+	// nested marks the Assertions of a check whose failures the enclosing
+	// assertion reports; see recordTo.
+	nested bool
+	// annotations controls GitHub Actions annotations of failures.
+	annotations GitHubAnnotations
+	// labels are extra labeled contents appended to every failure.
+	labels []labeledContent
+	// sourceLine includes the source line of the assertion call in failure
+	// messages.
+	sourceLine bool
+	// errorTrace selects the stack frames shown in the Error Trace.
+	errorTrace errorTraceConfig
+	// hooks run in order on failure, before onFailure.
+	hooks []FailureHook
+	// formatter replaces the default layout of failure messages.
+	formatter func(Failure) string
+	// values are the compared values of the assertion that is failing.
+	values *failureValues
+	// interceptors wrap every assertion call; see Use.
+	interceptors []func(AssertionCall) AssertionCall
+	// assertion is the name of the intercepted assertion running on the
+	// Assertions, which the stack of a failure does not tell once user
+	// interceptors are involved.
+	assertion string
+	// jsonLinesConfig customizes JSONLinesEq; see WithJSONLinesOptions.
+	jsonLinesConfig jsonLinesConfig
+	// yamlConfig customizes YAMLEq; see WithYAMLOptions.
+	yamlConfig yamlConfig
+	// steps are the names of the nested steps the assertions belong to.
+	steps []string
+	// convertibleStructs makes EqualValues compare structs of different
+	// types with identical layouts field by field.
+	convertibleStructs bool
+	// counter counts assertion calls; see Counting.
+	counter *int64
+	// prefix is prepended to the user message of every failure; see
+	// WithPrefix.
+	prefix string
+	// maxDiffLines, color and truncateAt are set with the Options of New.
+	maxDiffLines int
+	color        bool
+	truncateAt   int
+	// strictEquality makes Equal and NotEqual ignore Equal methods; see
+	// WithStrictEquality.
+	strictEquality bool
+}
+
+// New makes a new Assertions object for the specified TestingT. Any
+// testing.TB satisfies TestingT, so *testing.T, *testing.B and *testing.F
+// can be passed directly. The opts configure how failures are rendered.
+func New(t TestingT, opts ...Option) *Assertions {
+	a := &Assertions{
 		t: t,
 		onFailure: func(t TestingT) {
 			t.FailNow()
 		},
+		clock: realClock{},
 	}
+	if counter := testCounter(t); counter != nil {
+		a = a.countingWith(counter)
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
 }
 
 // WithOnFailure returns a new Assertions with customized behaviour on failure.
+// It replaces the default behaviour, but not the hooks added with
+// AddOnFailure.
 func (a *Assertions) WithOnFailure(f func(TestingT)) *Assertions {
-	return &Assertions{
-		t:         a.t,
-		onFailure: f,
-	}
+	c := *a
+	c.onFailure = f
+	c.onFailureDetail = nil
+	return &c
+}
+
+// WithOnFailureDetail is like WithOnFailure, but f also receives the
+// Failure, e.g. to ship failures to a dashboard without parsing the
+// failure message.
+//
+//	a := assert.New(t).WithOnFailureDetail(func(t assert.TestingT, f assert.Failure) {
+//		report(f.Test, f.Assertion, f.Diff)
+//		t.FailNow()
+//	})
+func (a *Assertions) WithOnFailureDetail(f func(TestingT, Failure)) *Assertions {
+	c := *a
+	c.onFailureDetail = f
+	return &c
+}
+
+// Quiet returns a new Assertions whose assertions only return their
+// verdict: failures are neither formatted nor reported, and the on-failure
+// behaviour is not triggered. Assertions that stop the test on failure,
+// such as MustNoError and AsType, do not stop it either, so their results
+// are unchecked. It suits fuzz targets that check many inputs per second
+// and only need the final result.
+//
+//	if !assert.New(t).Quiet().Equal(want, got) {
+//		t.Fatalf("mismatch for input %q", input)
+//	}
+func (a *Assertions) Quiet() *Assertions {
+	c := *a
+	c.quiet = true
+	return &c
+}
+
+// WithPrefix returns a new Assertions whose failure messages are prefixed
+// with the formatted context. It saves repeating msgAndArgs on every call
+// inside loops; prefixes of nested calls are joined with ", ".
+//
+//	for _, stage := range stages {
+//		a := a.WithPrefix("stage=%s", stage.Name)
+//		a.NoError(stage.Run())
+//		a.Equal(stage.Want, stage.Got())
+//	}
+func (a *Assertions) WithPrefix(format string, args ...any) *Assertions {
+	c := *a
+	prefix := fmt.Sprintf(format, args...)
+	if a.prefix != "" {
+		prefix = a.prefix + ", " + prefix
+	}
+	c.prefix = prefix
+	return &c
+}
+
+// T returns the TestingT the Assertions reports to. Packages that build
+// assertions on top of Assertions use it to mark their functions as test
+// helpers, so that failures are reported at their callers:
+//
+//	if h, ok := a.T().(interface{ Helper() }); ok {
+//		h.Helper()
+//	}
+func (a *Assertions) T() TestingT {
+	return a.t
+}
+
+// withT returns a copy of the Assertions that reports through t.
+func (a *Assertions) withT(t TestingT) *Assertions {
+	c := *a
+	c.t = t
+	return &c
+}
+
+// withLabel returns a copy of the Assertions whose failures additionally
+// carry the given labeled content.
+func (a *Assertions) withLabel(label, content string) *Assertions {
+	c := *a
+	c.labels = append(append([]labeledContent(nil), a.labels...), labeledContent{label, content})
+	return &c
 }
 
 // TestingT is an interface wrapper around *testing.T
@@ -73,10 +221,20 @@ type Comparison func() (success bool)
 	Helper functions
 */
 
-// ObjectsAreEqual determines if two objects are considered equal.
+// ObjectsAreEqual determines if two objects are considered equal. Objects
+// of the same type with an Equal method, see Equaler, are compared with it,
+// e.g. time.Time and net.IP; others are compared structurally.
 //
 // This function does no assertion of any kind.
 func ObjectsAreEqual(expected, actual any) bool {
+	if equal, ok := equalerEqual(expected, actual); ok {
+		return equal
+	}
+	return objectsAreStructurallyEqual(expected, actual)
+}
+
+// objectsAreStructurallyEqual is ObjectsAreEqual without Equal methods.
+func objectsAreStructurallyEqual(expected, actual any) bool {
 	if expected == nil || actual == nil {
 		return expected == actual
 	}
@@ -124,62 +282,7 @@ the problem actually occurred in calling code.*/
 // of each stack frame leading from the current test to the assert call that
 // failed.
 func CallerInfo() []string {
-	var pc uintptr
-	var ok bool
-	var file string
-	var line int
-	var name string
-
-	var callers []string
-	for i := 0; ; i++ {
-		pc, file, line, ok = runtime.Caller(i)
-		if !ok {
-			// The breaks below failed to terminate the loop, and we ran off the
-			// end of the call stack.
-			break
-		}
-
-		// This is a huge edge case, but it will panic if this is the case
-		// see https://github.com/stretchr/testify/issues/180
-		if file == "<autogenerated>" {
-			break
-		}
-
-		f := runtime.FuncForPC(pc)
-		if f == nil {
-			break
-		}
-		name = f.Name()
-
-		// testing.tRunner is the standard library function that calls
-		// tests. Subtests are called directly by tRunner, without going through
-		// the Test/Benchmark/Example function that contains the t.Run calls, so
-		// with subtests we should break when we hit tRunner, without adding it
-		// to the list of callers.
-		if name == "testing.tRunner" {
-			break
-		}
-
-		parts := strings.Split(file, "/")
-		file = parts[len(parts)-1]
-		if len(parts) > 1 {
-			dir := parts[len(parts)-2]
-			if dir != "assert" {
-				callers = append(callers, fmt.Sprintf("%s:%d", file, line))
-			}
-		}
-
-		// Drop the package
-		segments := strings.Split(name, ".")
-		name = segments[len(segments)-1]
-		if isTest(name, "Test") ||
-			isTest(name, "Benchmark") ||
-			isTest(name, "Example") {
-			break
-		}
-	}
-
-	return callers
+	return callerInfo(errorTraceConfig{})
 }
 
 // Stolen from the `go test` tool.
@@ -235,40 +338,55 @@ func indentMessageLines(message string, longestLabelLen int) string {
 
 // FailNow fails test
 func (a *Assertions) FailNow(failureMessage string, msgAndArgs ...any) bool {
+	if disabled {
+		return false
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 	a.Fail(failureMessage, msgAndArgs...)
+	if a.quiet {
+		return false
+	}
 	a.t.FailNow()
 	return false
 }
 
 // Fail reports a failure through
 func (a *Assertions) Fail(failureMessage string, msgAndArgs ...any) bool {
-	defer a.onFailure(a.t)
-
+	if disabled {
+		return false
+	}
+	if a.quiet {
+		return false
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-
-	content := []labeledContent{
-		{"Error Trace", strings.Join(CallerInfo(), "\n\t\t\t")},
-		{"Error", failureMessage},
+	failure := a.newFailure(failureMessage, msgAndArgs...)
+	a.emitFailure(failure)
+	if a.deferred != nil {
+		group := failure.Assertion
+		if failure.Step != "" {
+			group = failure.Step + " > " + group
+		}
+		a.deferred.add(group, a.render(failure))
+		return false
 	}
+	defer a.runOnFailure(failure)
 
-	// Add test name if the Go version supports it
-	if n, ok := a.t.(interface {
-		Name() string
-	}); ok {
-		content = append(content, labeledContent{"Test", n.Name()})
+	// A failing assertion inside a b.N loop fails on every iteration; only
+	// the first failure is worth formatting.
+	if b, ok := a.t.(benchmarkT); ok && b.Failed() {
+		return false
 	}
 
-	message := messageFromMsgAndArgs(msgAndArgs...)
-	if len(message) > 0 {
-		content = append(content, labeledContent{"Messages", message})
+	if a.annotate(failure) {
+		a.t.Errorf("\n%s", failureMessage)
+		return false
 	}
 
-	a.t.Errorf("\n%s", ""+labeledOutput(content...))
+	a.t.Errorf("\n%s", ""+a.render(failure))
 	return false
 }
 
@@ -279,7 +397,7 @@ type labeledContent struct {
 
 // labeledOutput returns a string consisting of the provided labeledContent. Each labeled output is appended in the following manner:
 //
-//   \t{{label}}:{{align_spaces}}\t{{content}}\n
+//	\t{{label}}:{{align_spaces}}\t{{content}}\n
 //
 // The initial carriage return is required to undo/erase any padding added by testing.T.Errorf. The "\t{{label}}:" is for the label.
 // If a label is shorter than the longest label provided, padding spaces are added to make all the labels match in length. Once this
@@ -302,6 +420,14 @@ func labeledOutput(content ...labeledContent) string {
 
 // Implements asserts that an object is implemented by the specified interface.
 func (a *Assertions) Implements(interfaceObject any, object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Implements", []any{interfaceObject, object, msgAndArgs}, func(a *Assertions) bool {
+			return a.Implements(interfaceObject, object, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -317,8 +443,42 @@ func (a *Assertions) Implements(interfaceObject any, object any, msgAndArgs ...a
 	return true
 }
 
+// NotImplements asserts that an object does not implement the specified
+// interface.
+func (a *Assertions) NotImplements(interfaceObject any, object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotImplements", []any{interfaceObject, object, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotImplements(interfaceObject, object, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	interfaceType := reflect.TypeOf(interfaceObject).Elem()
+
+	if object == nil {
+		return a.Fail(fmt.Sprintf("Cannot check if nil does not implement %v", interfaceType), msgAndArgs...)
+	}
+	if reflect.TypeOf(object).Implements(interfaceType) {
+		return a.Fail(fmt.Sprintf("%T implements %v", object, interfaceType), msgAndArgs...)
+	}
+
+	return true
+}
+
 // IsType asserts that the specified objects are of the same type.
 func (a *Assertions) IsType(expectedType any, object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("IsType", []any{expectedType, object, msgAndArgs}, func(a *Assertions) bool {
+			return a.IsType(expectedType, object, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -330,11 +490,50 @@ func (a *Assertions) IsType(expectedType any, object any, msgAndArgs ...any) boo
 	return true
 }
 
+// IsKind asserts that the specified object is of the given reflect.Kind.
+//
+//	a.IsKind(reflect.Slice, []int{1, 2})
+func (a *Assertions) IsKind(expectedKind reflect.Kind, object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("IsKind", []any{expectedKind, object, msgAndArgs}, func(a *Assertions) bool {
+			return a.IsKind(expectedKind, object, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if object == nil {
+		return a.Fail(fmt.Sprintf("Object expected to be of kind %v, but was nil", expectedKind), msgAndArgs...)
+	}
+
+	if kind := reflect.TypeOf(object).Kind(); kind != expectedKind {
+		return a.Fail(fmt.Sprintf("Object expected to be of kind %v, but was %v (%T)", expectedKind, kind, object), msgAndArgs...)
+	}
+
+	return true
+}
+
 // Equal asserts that two objects are equal.
 // Pointer variable equality is determined based on the equality of the
 // referenced values (as opposed to the memory addresses). Function equality
 // cannot be determined and will always fail.
+//
+// Values with an Equal method are compared with it, so time.Time values are
+// equal if they are the same instant, regardless of their location and
+// monotonic clock reading; failures print them in RFC 3339.
 func (a *Assertions) Equal(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Equal", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.Equal(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -343,10 +542,14 @@ func (a *Assertions) Equal(expected, actual any, msgAndArgs ...any) bool {
 			expected, actual, err), msgAndArgs...)
 	}
 
-	if !ObjectsAreEqual(expected, actual) {
-		diff := diff(expected, actual)
+	if !a.objectsAreEqual(expected, actual) {
+		if a.quiet {
+			return false
+		}
+		diff := a.diff(expected, actual) + a.formatMapJSONPatch(expected, actual) + lineEndingsNote(expected, actual)
+		failing := a.withValues(expected, actual)
 		expected, actual = formatUnequalValues(expected, actual)
-		return a.Fail(fmt.Sprintf("Not equal: \n"+
+		return failing.Fail(fmt.Sprintf("Not equal: \n"+
 			"expected: %s\n"+
 			"actual  : %s%s", expected, actual, diff), msgAndArgs...)
 	}
@@ -371,6 +574,14 @@ func validateEqualArgs(expected, actual any) error {
 // Both arguments must be pointer variables. Pointer variable sameness is
 // determined based on the equality of both type and value.
 func (a *Assertions) Same(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Same", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.Same(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -388,6 +599,14 @@ func (a *Assertions) Same(expected, actual any, msgAndArgs ...any) bool {
 // Both arguments must be pointer variables. Pointer variable sameness is
 // determined based on the equality of both type and value.
 func (a *Assertions) NotSame(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotSame", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotSame(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -431,6 +650,8 @@ func formatUnequalValues(expected, actual any) (e string, a string) {
 	switch expected.(type) {
 	case time.Duration:
 		return fmt.Sprintf("%v", expected), fmt.Sprintf("%v", actual)
+	case time.Time:
+		return expected.(time.Time).Format(time.RFC3339Nano), actual.(time.Time).Format(time.RFC3339Nano)
 	}
 	return truncatingFormat(expected), truncatingFormat(actual)
 }
@@ -451,14 +672,26 @@ func truncatingFormat(data any) string {
 // EqualValues asserts that two objects are equal or convertable to the same types
 // and equal.
 func (a *Assertions) EqualValues(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EqualValues", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.EqualValues(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 
-	if !ObjectsAreEqualValues(expected, actual) {
-		diff := diff(expected, actual)
+	if !a.objectsAreEqualValues(expected, actual) {
+		if a.quiet {
+			return false
+		}
+		diff := a.diff(expected, actual)
+		failing := a.withValues(expected, actual)
 		expected, actual = formatUnequalValues(expected, actual)
-		return a.Fail(fmt.Sprintf("Not equal: \n"+
+		return failing.Fail(fmt.Sprintf("Not equal: \n"+
 			"expected: %s\n"+
 			"actual  : %s%s", expected, actual, diff), msgAndArgs...)
 	}
@@ -469,6 +702,14 @@ func (a *Assertions) EqualValues(expected, actual any, msgAndArgs ...any) bool {
 
 // Exactly asserts that two objects are equal in value and type.
 func (a *Assertions) Exactly(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Exactly", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.Exactly(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -477,14 +718,66 @@ func (a *Assertions) Exactly(expected, actual any, msgAndArgs ...any) bool {
 	bType := reflect.TypeOf(actual)
 
 	if aType != bType {
-		return a.Fail(fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType), msgAndArgs...)
+		return a.withValues(expected, actual).Fail(fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType), msgAndArgs...)
 	}
 
 	return a.Equal(expected, actual, msgAndArgs...)
 }
 
+// ExactlySameType asserts that two objects are equal in value and type,
+// like Exactly, but reports the type and the value comparison separately,
+// so that the failure tells which of them differed.
+func (a *Assertions) ExactlySameType(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ExactlySameType", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.ExactlySameType(expected, actual, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	aType := reflect.TypeOf(expected)
+	bType := reflect.TypeOf(actual)
+	sameType := aType == bType
+	equal := a.objectsAreEqual(expected, actual)
+	if sameType && equal {
+		return true
+	}
+
+	typeLine := fmt.Sprintf("same (%v)", aType)
+	if !sameType {
+		typeLine = fmt.Sprintf("expected %v, actual %v", aType, bType)
+	}
+	var valueLine, diff string
+	switch {
+	case equal:
+		valueLine = "equal"
+	case ObjectsAreEqualValues(expected, actual):
+		valueLine = fmt.Sprintf("equal after converting to %v", bType)
+	default:
+		e, v := formatUnequalValues(expected, actual)
+		valueLine = fmt.Sprintf("expected %s, actual %s", e, v)
+		diff = a.diff(expected, actual)
+	}
+	return a.withValues(expected, actual).Fail(fmt.Sprintf("Not exactly equal:\n"+
+		"type : %s\n"+
+		"value: %s%s", typeLine, valueLine, diff), msgAndArgs...)
+}
+
 // NotNil asserts that the specified object is not nil.
 func (a *Assertions) NotNil(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotNil", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotNil(object, msgAndArgs...)
+		})
+	}
 	if !isNil(object) {
 		return true
 	}
@@ -529,6 +822,14 @@ func isNil(object any) bool {
 
 // Nil asserts that the specified object is nil.
 func (a *Assertions) Nil(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Nil", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.Nil(object, msgAndArgs...)
+		})
+	}
 	if isNil(object) {
 		return true
 	}
@@ -538,6 +839,14 @@ func (a *Assertions) Nil(object any, msgAndArgs ...any) bool {
 	return a.Fail(fmt.Sprintf("Expected nil, but got: %#v", object), msgAndArgs...)
 }
 
+// IsEmptyValue reports whether object is considered empty by Empty and
+// NotEmpty: nil, a zero value, a collection without elements, or a pointer
+// to an empty value. Custom assertions and matchers should use it to agree
+// with the built-in ones.
+func IsEmptyValue(object any) bool {
+	return isEmpty(object)
+}
+
 // isEmpty gets whether the specified object is considered empty or not.
 func isEmpty(object any) bool {
 	// get nil case out of the way
@@ -545,6 +854,34 @@ func isEmpty(object any) bool {
 		return true
 	}
 
+	// common types skip reflection, which dominates in tight loops
+	switch o := object.(type) {
+	case string:
+		return o == ""
+	case bool:
+		return !o
+	case int:
+		return o == 0
+	case int64:
+		return o == 0
+	case float64:
+		return o == 0
+	case []byte:
+		return len(o) == 0
+	case []string:
+		return len(o) == 0
+	case []int:
+		return len(o) == 0
+	case []any:
+		return len(o) == 0
+	case map[string]string:
+		return len(o) == 0
+	case map[string]any:
+		return len(o) == 0
+	case chan struct{}:
+		return len(o) == 0
+	}
+
 	objValue := reflect.ValueOf(object)
 
 	switch objValue.Kind() {
@@ -560,14 +897,42 @@ func isEmpty(object any) bool {
 		return isEmpty(deref)
 	// for all other types, compare against the zero value
 	default:
-		zero := reflect.Zero(objValue.Type())
-		return reflect.DeepEqual(object, zero.Interface())
+		return isZero(object)
 	}
 }
 
+// zeroer is implemented by types like time.Time and netip.Addr that define
+// their own notion of a zero value.
+type zeroer interface {
+	IsZero() bool
+}
+
+// isZero tells whether i is nil or the zero value of its type. Values that
+// implement IsZero() bool decide for themselves, so that e.g. a time.Time
+// is zero regardless of its location or monotonic clock reading.
+func isZero(i any) bool {
+	if i == nil {
+		return true
+	}
+	v := reflect.ValueOf(i)
+	if z, ok := i.(zeroer); ok && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		return z.IsZero()
+	}
+	return reflect.DeepEqual(i, reflect.Zero(v.Type()).Interface())
+}
+
 // Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// a slice or a channel with len == 0. Values with an IsZero() bool method are
+// empty when it returns true.
 func (a *Assertions) Empty(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Empty", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.Empty(object, msgAndArgs...)
+		})
+	}
 	if !isEmpty(object) {
 		if h, ok := a.t.(tHelper); ok {
 			h.Helper()
@@ -581,6 +946,14 @@ func (a *Assertions) Empty(object any, msgAndArgs ...any) bool {
 // NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
 // a slice or a channel with len == 0.
 func (a *Assertions) NotEmpty(object any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotEmpty", []any{object, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotEmpty(object, msgAndArgs...)
+		})
+	}
 	if isEmpty(object) {
 		if h, ok := a.t.(tHelper); ok {
 			h.Helper()
@@ -591,9 +964,36 @@ func (a *Assertions) NotEmpty(object any, msgAndArgs ...any) bool {
 	return true
 }
 
+// ValueLen returns the length of x as used by Len, and whether x has a length
+// at all, i.e. whether the builtin len() accepts it.
+func ValueLen(x any) (int, bool) {
+	ok, length := getLen(x)
+	return length, ok
+}
+
 // getLen try to get length of object.
 // return (false, 0) if impossible.
 func getLen(x any) (ok bool, length int) {
+	// common types skip reflection, which dominates in tight loops
+	switch o := x.(type) {
+	case string:
+		return true, len(o)
+	case []byte:
+		return true, len(o)
+	case []string:
+		return true, len(o)
+	case []int:
+		return true, len(o)
+	case []any:
+		return true, len(o)
+	case map[string]string:
+		return true, len(o)
+	case map[string]any:
+		return true, len(o)
+	case chan struct{}:
+		return true, len(o)
+	}
+
 	v := reflect.ValueOf(x)
 	defer func() {
 		if e := recover(); e != nil {
@@ -606,21 +1006,37 @@ func getLen(x any) (ok bool, length int) {
 // Len asserts that the specified object has specific length.
 // Len also fails if the object has a type that len() not accept.
 func (a *Assertions) Len(object any, length int, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Len", []any{object, length, msgAndArgs}, func(a *Assertions) bool {
+			return a.Len(object, length, msgAndArgs...)
+		})
+	}
+	ok, l := getLen(object)
+	if ok && l == length {
+		return true
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	ok, l := getLen(object)
 	if !ok {
 		return a.Fail(fmt.Sprintf("\"%s\" could not be applied builtin len()", object), msgAndArgs...)
 	}
-	if l != length {
-		return a.Fail(fmt.Sprintf("\"%s\" should have %d item(s), but has %d", object, length, l), msgAndArgs...)
-	}
-	return true
+	return a.Fail(fmt.Sprintf("\"%s\" should have %d item(s), but has %d", object, length, l), msgAndArgs...)
 }
 
 // True asserts that the specified value is true.
 func (a *Assertions) True(value bool, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("True", []any{value, msgAndArgs}, func(a *Assertions) bool {
+			return a.True(value, msgAndArgs...)
+		})
+	}
 	if !value {
 		if h, ok := a.t.(tHelper); ok {
 			h.Helper()
@@ -632,6 +1048,14 @@ func (a *Assertions) True(value bool, msgAndArgs ...any) bool {
 
 // False asserts that the specified value is false.
 func (a *Assertions) False(value bool, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("False", []any{value, msgAndArgs}, func(a *Assertions) bool {
+			return a.False(value, msgAndArgs...)
+		})
+	}
 	if value {
 		if h, ok := a.t.(tHelper); ok {
 			h.Helper()
@@ -645,6 +1069,14 @@ func (a *Assertions) False(value bool, msgAndArgs ...any) bool {
 // Pointer variable equality is determined based on the equality of the
 // referenced values (as opposed to the memory addresses).
 func (a *Assertions) NotEqual(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotEqual", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotEqual(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -653,7 +1085,7 @@ func (a *Assertions) NotEqual(expected, actual any, msgAndArgs ...any) bool {
 			expected, actual, err), msgAndArgs...)
 	}
 
-	if ObjectsAreEqual(expected, actual) {
+	if a.objectsAreEqual(expected, actual) {
 		return a.Fail(fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
 	}
 
@@ -662,11 +1094,19 @@ func (a *Assertions) NotEqual(expected, actual any, msgAndArgs ...any) bool {
 
 // NotEqualValues asserts that two objects are not equal even when converted to the same type
 func (a *Assertions) NotEqualValues(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotEqualValues", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotEqualValues(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 
-	if ObjectsAreEqualValues(expected, actual) {
+	if a.objectsAreEqualValues(expected, actual) {
 		return a.Fail(fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
 	}
 
@@ -699,7 +1139,7 @@ func containsElement(list any, element any) (ok, found bool) {
 	if listKind == reflect.Map {
 		mapKeys := listValue.MapKeys()
 		for i := 0; i < len(mapKeys); i++ {
-			if ObjectsAreEqual(mapKeys[i].Interface(), element) {
+			if matchesElement(element, mapKeys[i].Interface()) {
 				return true, true
 			}
 		}
@@ -707,7 +1147,7 @@ func containsElement(list any, element any) (ok, found bool) {
 	}
 
 	for i := 0; i < listValue.Len(); i++ {
-		if ObjectsAreEqual(listValue.Index(i).Interface(), element) {
+		if matchesElement(element, listValue.Index(i).Interface()) {
 			return true, true
 		}
 	}
@@ -716,8 +1156,17 @@ func containsElement(list any, element any) (ok, found bool) {
 }
 
 // Contains asserts that the specified string, list(array, slice...) or map contains the
-// specified substring or element.
+// specified substring or element. An element that is a Matcher is matched
+// against the elements of lists and the keys of maps.
 func (a *Assertions) Contains(s, contains any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Contains", []any{s, contains, msgAndArgs}, func(a *Assertions) bool {
+			return a.Contains(s, contains, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -736,6 +1185,14 @@ func (a *Assertions) Contains(s, contains any, msgAndArgs ...any) bool {
 // NotContains asserts that the specified string, list(array, slice...) or map does NOT contain the
 // specified substring or element.
 func (a *Assertions) NotContains(s, contains any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotContains", []any{s, contains, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotContains(s, contains, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -754,6 +1211,14 @@ func (a *Assertions) NotContains(s, contains any, msgAndArgs ...any) bool {
 // Subset asserts that the specified list(array, slice, map...) contains all
 // elements given in the specified subset(array, slice, map...).
 func (a *Assertions) Subset(list, subset any, msgAndArgs ...any) (ok bool) {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Subset", []any{list, subset, msgAndArgs}, func(a *Assertions) bool {
+			return a.Subset(list, subset, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -819,6 +1284,14 @@ func (a *Assertions) Subset(list, subset any, msgAndArgs ...any) (ok bool) {
 // NotSubset asserts that the specified list(array, slice...) contains not all
 // elements given in the specified subset(array, slice...).
 func (a *Assertions) NotSubset(list, subset any, msgAndArgs ...any) (ok bool) {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotSubset", []any{list, subset, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotSubset(list, subset, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -884,7 +1357,19 @@ func (a *Assertions) NotSubset(list, subset any, msgAndArgs ...any) (ok bool) {
 // ElementsMatch asserts that the specified listA(array, slice...) is equal to specified
 // listB(array, slice...) ignoring the order of the elements. If there are duplicate elements,
 // the number of appearances of each of them in both lists should match.
+//
+// Elements of listA that are Matchers match elements of listB instead of
+// being compared for equality. Each element of listA is paired with the
+// first unpaired element of listB it matches.
 func (a *Assertions) ElementsMatch(listA, listB any, msgAndArgs ...any) (ok bool) {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ElementsMatch", []any{listA, listB, msgAndArgs}, func(a *Assertions) bool {
+			return a.ElementsMatch(listA, listB, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -933,7 +1418,7 @@ func diffLists(listA, listB any) (extraA, extraB []any) {
 			if visited[j] {
 				continue
 			}
-			if ObjectsAreEqual(bValue.Index(j).Interface(), element) {
+			if matchesElement(element, bValue.Index(j).Interface()) {
 				visited[j] = true
 				found = true
 				break
@@ -976,6 +1461,14 @@ func formatListDiff(listA, listB any, extraA, extraB []any) string {
 
 // Condition uses a Comparison to assert a complex condition.
 func (a *Assertions) Condition(comp Comparison, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Condition", []any{comp, msgAndArgs}, func(a *Assertions) bool {
+			return a.Condition(comp, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1007,8 +1500,35 @@ func didPanic(f PanicTestFunc) (didPanic bool, message any, stack string) {
 	return
 }
 
+// formatPanicValue formats a recovered panic value along with its concrete
+// type. If the value is an error, the chain of errors it wraps is listed too,
+// so that errors with the same message but different types can be told apart.
+func formatPanicValue(v any) string {
+	s := fmt.Sprintf("%+v (%T)", v, v)
+	err, ok := v.(error)
+	if !ok {
+		return s
+	}
+	var chain []string
+	for err = errors.Unwrap(err); err != nil; err = errors.Unwrap(err) {
+		chain = append(chain, fmt.Sprintf("%+v (%T)", err, err))
+	}
+	if len(chain) > 0 {
+		s += "\n\tPanic error chain:\t" + strings.Join(chain, "\n\t\t\t")
+	}
+	return s
+}
+
 // Panics asserts that the code inside the specified PanicTestFunc panics.
 func (a *Assertions) Panics(f PanicTestFunc, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Panics", []any{f, msgAndArgs}, func(a *Assertions) bool {
+			return a.Panics(f, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1023,6 +1543,14 @@ func (a *Assertions) Panics(f PanicTestFunc, msgAndArgs ...any) bool {
 // PanicsWithValue asserts that the code inside the specified PanicTestFunc panics, and that
 // the recovered panic value equals the expected panic value.
 func (a *Assertions) PanicsWithValue(expected any, f PanicTestFunc, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("PanicsWithValue", []any{expected, f, msgAndArgs}, func(a *Assertions) bool {
+			return a.PanicsWithValue(expected, f, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1032,7 +1560,7 @@ func (a *Assertions) PanicsWithValue(expected any, f PanicTestFunc, msgAndArgs .
 		return a.Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue), msgAndArgs...)
 	}
 	if panicValue != expected {
-		return a.Fail(fmt.Sprintf("func %#v should panic with value:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, expected, panicValue, panickedStack), msgAndArgs...)
+		return a.Fail(fmt.Sprintf("func %#v should panic with value:\t%s\n\tPanic value:\t%s\n\tPanic stack:\t%s", f, formatPanicValue(expected), formatPanicValue(panicValue), panickedStack), msgAndArgs...)
 	}
 
 	return true
@@ -1042,6 +1570,14 @@ func (a *Assertions) PanicsWithValue(expected any, f PanicTestFunc, msgAndArgs .
 // panics, and that the recovered panic value is an error that satisfies the
 // EqualError comparison.
 func (a *Assertions) PanicsWithError(errString string, f PanicTestFunc, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("PanicsWithError", []any{errString, f, msgAndArgs}, func(a *Assertions) bool {
+			return a.PanicsWithError(errString, f, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1052,7 +1588,7 @@ func (a *Assertions) PanicsWithError(errString string, f PanicTestFunc, msgAndAr
 	}
 	panicErr, ok := panicValue.(error)
 	if !ok || panicErr.Error() != errString {
-		return a.Fail(fmt.Sprintf("func %#v should panic with error message:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, errString, panicValue, panickedStack), msgAndArgs...)
+		return a.Fail(fmt.Sprintf("func %#v should panic with error message:\t%#v\n\tPanic value:\t%s\n\tPanic stack:\t%s", f, errString, formatPanicValue(panicValue), panickedStack), msgAndArgs...)
 	}
 
 	return true
@@ -1060,6 +1596,14 @@ func (a *Assertions) PanicsWithError(errString string, f PanicTestFunc, msgAndAr
 
 // NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
 func (a *Assertions) NotPanics(f PanicTestFunc, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotPanics", []any{f, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotPanics(f, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1073,6 +1617,14 @@ func (a *Assertions) NotPanics(f PanicTestFunc, msgAndArgs ...any) bool {
 
 // WithinDuration asserts that the two times are within duration delta of each other.
 func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("WithinDuration", []any{expected, actual, delta, msgAndArgs}, func(a *Assertions) bool {
+			return a.WithinDuration(expected, actual, delta, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1087,6 +1639,14 @@ func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Durat
 
 // WithinTimeRange asserts that a time is within a time range (inclusive).
 func (a *Assertions) WithinTimeRange(actual, start, end time.Time, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("WithinTimeRange", []any{actual, start, end, msgAndArgs}, func(a *Assertions) bool {
+			return a.WithinTimeRange(actual, start, end, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1144,6 +1704,14 @@ func toFloat(x any) (float64, bool) {
 
 // InDelta asserts that the two numerals are within delta of each other.
 func (a *Assertions) InDelta(expected, actual any, delta float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InDelta", []any{expected, actual, delta, msgAndArgs}, func(a *Assertions) bool {
+			return a.InDelta(expected, actual, delta, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1177,6 +1745,14 @@ func (a *Assertions) InDelta(expected, actual any, delta float64, msgAndArgs ...
 
 // InDeltaSlice is the same as InDelta, except it compares two slices.
 func (a *Assertions) InDeltaSlice(expected, actual any, delta float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InDeltaSlice", []any{expected, actual, delta, msgAndArgs}, func(a *Assertions) bool {
+			return a.InDeltaSlice(expected, actual, delta, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1198,8 +1774,69 @@ func (a *Assertions) InDeltaSlice(expected, actual any, delta float64, msgAndArg
 	return true
 }
 
+// maxReportedCells bounds the number of cells InDelta2D reports on failure.
+const maxReportedCells = 10
+
+// InDelta2D is the same as InDelta, except it compares two matrices cell by
+// cell. Each out-of-tolerance cell is reported by its (row, col) coordinates.
+//
+//	a.InDelta2D([][]float64{{1, 2}, {3, 4}}, actual, 0.01)
+func (a *Assertions) InDelta2D(expected, actual [][]float64, delta float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InDelta2D", []any{expected, actual, delta, msgAndArgs}, func(a *Assertions) bool {
+			return a.InDelta2D(expected, actual, delta, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(expected) != len(actual) {
+		return a.Fail(fmt.Sprintf("Matrices have different numbers of rows: expected %d, actual %d", len(expected), len(actual)), msgAndArgs...)
+	}
+	for i := range expected {
+		if len(expected[i]) != len(actual[i]) {
+			return a.Fail(fmt.Sprintf("Row %d has different numbers of columns: expected %d, actual %d", i, len(expected[i]), len(actual[i])), msgAndArgs...)
+		}
+	}
+
+	var cells []string
+	violations := 0
+	for i := range expected {
+		for j, e := range expected[i] {
+			v := actual[i][j]
+			if math.IsNaN(e) && math.IsNaN(v) || math.Abs(e-v) <= delta {
+				continue
+			}
+			violations++
+			if len(cells) < maxReportedCells {
+				cells = append(cells, fmt.Sprintf("(%d, %d): expected %v, actual %v, difference %v", i, j, e, v, v-e))
+			}
+		}
+	}
+	if violations == 0 {
+		return true
+	}
+	msg := fmt.Sprintf("%d cell(s) differ by more than %v:\n\t%s", violations, delta, strings.Join(cells, "\n\t"))
+	if violations > len(cells) {
+		msg += fmt.Sprintf("\n\t... and %d more", violations-len(cells))
+	}
+	return a.Fail(msg, msgAndArgs...)
+}
+
 // InDeltaMapValues is the same as InDelta, but it compares all values between two maps. Both maps must have exactly the same keys.
 func (a *Assertions) InDeltaMapValues(expected, actual any, delta float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InDeltaMapValues", []any{expected, actual, delta, msgAndArgs}, func(a *Assertions) bool {
+			return a.InDeltaMapValues(expected, actual, delta, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1260,6 +1897,14 @@ func calcRelativeError(expected, actual any) (float64, error) {
 
 // InEpsilon asserts that expected and actual have a relative error less than epsilon
 func (a *Assertions) InEpsilon(expected, actual any, epsilon float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InEpsilon", []any{expected, actual, epsilon, msgAndArgs}, func(a *Assertions) bool {
+			return a.InEpsilon(expected, actual, epsilon, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1280,6 +1925,14 @@ func (a *Assertions) InEpsilon(expected, actual any, epsilon float64, msgAndArgs
 
 // InEpsilonSlice is the same as InEpsilon, except it compares each value from two slices.
 func (a *Assertions) InEpsilonSlice(expected, actual any, epsilon float64, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("InEpsilonSlice", []any{expected, actual, epsilon, msgAndArgs}, func(a *Assertions) bool {
+			return a.InEpsilonSlice(expected, actual, epsilon, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1307,6 +1960,14 @@ func (a *Assertions) InEpsilonSlice(expected, actual any, epsilon float64, msgAn
 
 // NoError asserts that a function returned no error (i.e. `nil`).
 func (a *Assertions) NoError(err error, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoError", []any{err, msgAndArgs}, func(a *Assertions) bool {
+			return a.NoError(err, msgAndArgs...)
+		})
+	}
 	if err != nil {
 		if h, ok := a.t.(tHelper); ok {
 			h.Helper()
@@ -1319,6 +1980,14 @@ func (a *Assertions) NoError(err error, msgAndArgs ...any) bool {
 
 // Error asserts that a function returned an error (i.e. not `nil`).
 func (a *Assertions) Error(err error, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Error", []any{err, msgAndArgs}, func(a *Assertions) bool {
+			return a.Error(err, msgAndArgs...)
+		})
+	}
 	if err == nil {
 		if h, ok := a.t.(tHelper); ok {
 			h.Helper()
@@ -1332,6 +2001,14 @@ func (a *Assertions) Error(err error, msgAndArgs ...any) bool {
 // EqualError asserts that a function returned an error (i.e. not `nil`)
 // and that it is equal to the provided error.
 func (a *Assertions) EqualError(theError error, errString string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EqualError", []any{theError, errString, msgAndArgs}, func(a *Assertions) bool {
+			return a.EqualError(theError, errString, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1352,6 +2029,14 @@ func (a *Assertions) EqualError(theError error, errString string, msgAndArgs ...
 // ErrorContains asserts that a function returned an error (i.e. not `nil`)
 // and that the error contains the specified substring.
 func (a *Assertions) ErrorContains(theError error, contains string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ErrorContains", []any{theError, contains, msgAndArgs}, func(a *Assertions) bool {
+			return a.ErrorContains(theError, contains, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1368,8 +2053,17 @@ func (a *Assertions) ErrorContains(theError error, contains string, msgAndArgs .
 }
 
 // ErrorRegexp asserts that a function returned an error (i.e. not `nil`)
-// and that the error is matched by a specified regexp.
+// and that the error is matched by a specified regexp, given as a
+// *regexp.Regexp, a string or a []byte.
 func (a *Assertions) ErrorRegexp(theError error, rx any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ErrorRegexp", []any{theError, rx, msgAndArgs}, func(a *Assertions) bool {
+			return a.ErrorRegexp(theError, rx, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1380,67 +2074,123 @@ func (a *Assertions) ErrorRegexp(theError error, rx any, msgAndArgs ...any) bool
 
 	actual := theError.Error()
 	if !matchRegexp(rx, actual) {
-		return a.Fail(fmt.Sprintf("Error %#v is not matched by %#v", actual, rx), msgAndArgs...)
+		return a.Fail(fmt.Sprintf("Error %#v is not matched by %#v", actual, regexpOperand(rx)), msgAndArgs...)
 	}
 
 	return true
 }
 
-// matchRegexp return true if a specified regexp matches a string.
+// matchRegexp return true if a specified regexp matches a string. Both may
+// also be given as []byte or fmt.Stringer; a []byte str is matched in place
+// without conversion.
 func matchRegexp(rx any, str any) bool {
 	var r *regexp.Regexp
-	if rr, ok := rx.(*regexp.Regexp); ok {
+	switch rr := rx.(type) {
+	case *regexp.Regexp:
 		r = rr
-	} else {
+	case []byte:
+		r = regexp.MustCompile(string(rr))
+	default:
 		r = regexp.MustCompile(fmt.Sprint(rx))
 	}
 
-	return r.FindStringIndex(fmt.Sprint(str)) != nil
+	switch s := str.(type) {
+	case []byte:
+		return r.Match(s)
+	case string:
+		return r.MatchString(s)
+	}
+	return r.MatchString(fmt.Sprint(str))
+}
+
+// regexpOperand returns v for failure messages of regexp assertions, with
+// a []byte shown as text.
+func regexpOperand(v any) any {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
 }
 
-// Regexp asserts that a specified regexp matches a string.
+// Regexp asserts that a specified regexp matches a string. The string may
+// also be a []byte, which is matched without copying, or a fmt.Stringer.
 func (a *Assertions) Regexp(rx any, str any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Regexp", []any{rx, str, msgAndArgs}, func(a *Assertions) bool {
+			return a.Regexp(rx, str, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 
 	if !matchRegexp(rx, str) {
-		return a.Fail(fmt.Sprintf("Expect \"%v\" to match \"%v\"", str, rx), msgAndArgs...)
+		return a.Fail(fmt.Sprintf("Expect \"%v\" to match \"%v\"", regexpOperand(str), regexpOperand(rx)), msgAndArgs...)
 	}
 
 	return true
 }
 
-// NotRegexp asserts that a specified regexp does not match a string.
+// NotRegexp asserts that a specified regexp does not match a string, a
+// []byte or a fmt.Stringer.
 func (a *Assertions) NotRegexp(rx any, str any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotRegexp", []any{rx, str, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotRegexp(rx, str, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 
 	if matchRegexp(rx, str) {
-		return a.Fail(fmt.Sprintf("Expect \"%v\" to NOT match \"%v\"", str, rx), msgAndArgs...)
+		return a.Fail(fmt.Sprintf("Expect \"%v\" to NOT match \"%v\"", regexpOperand(str), regexpOperand(rx)), msgAndArgs...)
 	}
 
 	return true
 }
 
-// Zero asserts that i is the zero value for its type.
+// Zero asserts that i is the zero value for its type. Types with an
+// IsZero() bool method, like time.Time, decide what their zero value is.
 func (a *Assertions) Zero(i any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Zero", []any{i, msgAndArgs}, func(a *Assertions) bool {
+			return a.Zero(i, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if i != nil && !reflect.DeepEqual(i, reflect.Zero(reflect.TypeOf(i)).Interface()) {
+	if !isZero(i) {
 		return a.Fail(fmt.Sprintf("Should be zero, but was %v", i), msgAndArgs...)
 	}
 	return true
 }
 
-// NotZero asserts that i is not the zero value for its type.
+// NotZero asserts that i is not the zero value for its type, as decided by
+// its IsZero() bool method if it has one.
 func (a *Assertions) NotZero(i any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotZero", []any{i, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotZero(i, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if i == nil || reflect.DeepEqual(i, reflect.Zero(reflect.TypeOf(i)).Interface()) {
+	if isZero(i) {
 		return a.Fail(fmt.Sprintf("Should not be zero, but was %v", i), msgAndArgs...)
 	}
 	return true
@@ -1449,6 +2199,14 @@ func (a *Assertions) NotZero(i any, msgAndArgs ...any) bool {
 // FileExists checks whether a file exists in the given path. It also fails if
 // the path points to a directory or there is an error when trying to check the file.
 func (a *Assertions) FileExists(path string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("FileExists", []any{path, msgAndArgs}, func(a *Assertions) bool {
+			return a.FileExists(path, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1468,6 +2226,14 @@ func (a *Assertions) FileExists(path string, msgAndArgs ...any) bool {
 // NoFileExists checks whether a file does not exist in a given path. It fails
 // if the path points to an existing _file_ only.
 func (a *Assertions) NoFileExists(path string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoFileExists", []any{path, msgAndArgs}, func(a *Assertions) bool {
+			return a.NoFileExists(path, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1484,6 +2250,14 @@ func (a *Assertions) NoFileExists(path string, msgAndArgs ...any) bool {
 // DirExists checks whether a directory exists in the given path. It also fails
 // if the path is a file rather a directory or there is an error checking whether it exists.
 func (a *Assertions) DirExists(path string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("DirExists", []any{path, msgAndArgs}, func(a *Assertions) bool {
+			return a.DirExists(path, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1503,6 +2277,14 @@ func (a *Assertions) DirExists(path string, msgAndArgs ...any) bool {
 // NoDirExists checks whether a directory does not exist in the given path.
 // It fails if the path points to an existing _directory_ only.
 func (a *Assertions) NoDirExists(path string, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NoDirExists", []any{path, msgAndArgs}, func(a *Assertions) bool {
+			return a.NoDirExists(path, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1519,40 +2301,88 @@ func (a *Assertions) NoDirExists(path string, msgAndArgs ...any) bool {
 	return a.Fail(fmt.Sprintf("directory %q exists", path), msgAndArgs...)
 }
 
-// JSONEq asserts that two JSON strings are equivalent.
-func (a *Assertions) JSONEq(expected string, actual string, msgAndArgs ...any) bool {
+// JSONEq asserts that two JSON documents are equivalent. Each document may
+// be given as a string, []byte, json.RawMessage or io.Reader.
+//
+//	a.JSONEq(`{"id": 1}`, resp.Body)
+func (a *Assertions) JSONEq(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("JSONEq", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.JSONEq(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	expectedDoc, actualDoc, err := documentTexts(expected, actual)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
+
 	var expectedJSONAsInterface, actualJSONAsInterface any
 
-	if err := json.Unmarshal([]byte(expected), &expectedJSONAsInterface); err != nil {
-		return a.Fail(fmt.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expected, err.Error()), msgAndArgs...)
+	if err := json.Unmarshal([]byte(expectedDoc), &expectedJSONAsInterface); err != nil {
+		return a.Fail(fmt.Sprintf("Expected value ('%s') is not valid json.\nJSON parsing error: '%s'", expectedDoc, err.Error()), msgAndArgs...)
 	}
 
-	if err := json.Unmarshal([]byte(actual), &actualJSONAsInterface); err != nil {
-		return a.Fail(fmt.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actual, err.Error()), msgAndArgs...)
+	if err := json.Unmarshal([]byte(actualDoc), &actualJSONAsInterface); err != nil {
+		return a.Fail(fmt.Sprintf("Input ('%s') needs to be valid json.\nJSON parsing error: '%s'", actualDoc, err.Error()), msgAndArgs...)
 	}
 
-	return a.Equal(expectedJSONAsInterface, actualJSONAsInterface, msgAndArgs...)
+	if differences := jsonPathDiff("$", expectedJSONAsInterface, actualJSONAsInterface); len(differences) > 0 {
+		return a.Fail(fmt.Sprintf("Not equal: \n"+
+			"expected: %s\n"+
+			"actual  : %s\n\n"+
+			"Differences:\n\t%s%s", expectedDoc, actualDoc, strings.Join(differences, "\n\t"),
+			a.formatJSONPatch(expectedJSONAsInterface, actualJSONAsInterface)), msgAndArgs...)
+	}
+
+	return true
 }
 
-// YAMLEq asserts that two YAML strings are equivalent.
-func (a *Assertions) YAMLEq(expected string, actual string, msgAndArgs ...any) bool {
+// YAMLEq asserts that two YAML documents are equivalent. Each document may
+// be given as a string, []byte or io.Reader. Multi-document streams are
+// compared document by document. The comparison is customized with
+// WithYAMLOptions.
+func (a *Assertions) YAMLEq(expected, actual any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("YAMLEq", []any{expected, actual, msgAndArgs}, func(a *Assertions) bool {
+			return a.YAMLEq(expected, actual, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	var expectedYAMLAsInterface, actualYAMLAsInterface any
+	config := a.yamlConfig
+	expectedDoc, actualDoc, err := documentTexts(expected, actual)
+	if err != nil {
+		return a.Fail(err.Error(), msgAndArgs...)
+	}
 
-	if err := yaml.Unmarshal([]byte(expected), &expectedYAMLAsInterface); err != nil {
-		return a.Fail(fmt.Sprintf("Expected value ('%s') is not valid yaml.\nYAML parsing error: '%s'", expected, err.Error()), msgAndArgs...)
+	expectedYAMLAsInterface, err := config.parse(expectedDoc)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Expected value ('%s') is not valid yaml.\nYAML parsing error: '%s'", expectedDoc, err.Error()), msgAndArgs...)
 	}
 
-	if err := yaml.Unmarshal([]byte(actual), &actualYAMLAsInterface); err != nil {
-		return a.Fail(fmt.Sprintf("Input ('%s') needs to be valid yaml.\nYAML error: '%s'", actual, err.Error()), msgAndArgs...)
+	actualYAMLAsInterface, err := config.parse(actualDoc)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Input ('%s') needs to be valid yaml.\nYAML error: '%s'", actualDoc, err.Error()), msgAndArgs...)
+	}
+
+	if differences := config.diff(expectedYAMLAsInterface, actualYAMLAsInterface); len(differences) > 0 {
+		return a.Fail(fmt.Sprintf("Not equal: \n"+
+			"expected: %s\n"+
+			"actual  : %s\n\n"+
+			"Differences:\n\t%s", expectedDoc, actualDoc, strings.Join(differences, "\n\t")), msgAndArgs...)
 	}
 
-	return a.Equal(expectedYAMLAsInterface, actualYAMLAsInterface, msgAndArgs...)
+	return true
 }
 
 func typeAndKind(v any) (reflect.Type, reflect.Kind) {
@@ -1569,46 +2399,11 @@ func typeAndKind(v any) (reflect.Type, reflect.Kind) {
 // diff returns a diff of both values as long as both are of the same type and
 // are a struct, map, slice, array or string. Otherwise it returns an empty string.
 func diff(expected any, actual any) string {
-	if expected == nil || actual == nil {
+	d := Diff(expected, actual)
+	if d == "" {
 		return ""
 	}
-
-	et, ek := typeAndKind(expected)
-	at, _ := typeAndKind(actual)
-
-	if et != at {
-		return ""
-	}
-
-	if ek != reflect.Struct && ek != reflect.Map && ek != reflect.Slice && ek != reflect.Array && ek != reflect.String {
-		return ""
-	}
-
-	var e, a string
-
-	switch et {
-	case reflect.TypeOf(""):
-		e = reflect.ValueOf(expected).String()
-		a = reflect.ValueOf(actual).String()
-	case reflect.TypeOf(time.Time{}):
-		e = spewConfigStringerEnabled.Sdump(expected)
-		a = spewConfigStringerEnabled.Sdump(actual)
-	default:
-		e = spewConfig.Sdump(expected)
-		a = spewConfig.Sdump(actual)
-	}
-
-	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
-		A:        difflib.SplitLines(e),
-		B:        difflib.SplitLines(a),
-		FromFile: "Expected",
-		FromDate: "",
-		ToFile:   "Actual",
-		ToDate:   "",
-		Context:  1,
-	})
-
-	return "\n\nDiff:\n" + diff
+	return "\n\nDiff:\n" + d
 }
 
 func isFunction(arg any) bool {
@@ -1639,33 +2434,50 @@ type tHelper interface {
 	Helper()
 }
 
+// benchmarkT is implemented by *testing.B.
+type benchmarkT interface {
+	Failed() bool
+	ReportAllocs()
+}
+
 // Eventually asserts that given condition will be met in waitFor time,
 // periodically checking target function each tick.
 func (a *Assertions) Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Eventually", []any{condition, waitFor, tick, msgAndArgs}, func(a *Assertions) bool {
+			return a.Eventually(condition, waitFor, tick, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 
 	ch := make(chan bool, 1)
+	var running *conditionGoroutine
+	defer func() { a.checkConditionExited("Eventually", running) }()
 
-	timer := time.NewTimer(waitFor)
+	timer := a.clock.NewTimer(waitFor)
 	defer timer.Stop()
 
-	ticker := time.NewTicker(tick)
+	ticker := a.clock.NewTicker(tick)
 	defer ticker.Stop()
 
-	for tick := ticker.C; ; {
+	for tick := ticker.C(); ; {
 		select {
-		case <-timer.C:
+		case <-timer.C():
 			return a.Fail("Condition never satisfied", msgAndArgs...)
 		case <-tick:
 			tick = nil
-			go func() { ch <- condition() }()
+			running = goCondition(condition, ch)
 		case v := <-ch:
+			running = nil
 			if v {
 				return true
 			}
-			tick = ticker.C
+			tick = ticker.C()
 		}
 	}
 }
@@ -1673,30 +2485,41 @@ func (a *Assertions) Eventually(condition func() bool, waitFor time.Duration, ti
 // Never asserts that the given condition doesn't satisfy in waitFor time,
 // periodically checking the target function each tick.
 func (a *Assertions) Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("Never", []any{condition, waitFor, tick, msgAndArgs}, func(a *Assertions) bool {
+			return a.Never(condition, waitFor, tick, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
 
 	ch := make(chan bool, 1)
+	var running *conditionGoroutine
+	defer func() { a.checkConditionExited("Never", running) }()
 
-	timer := time.NewTimer(waitFor)
+	timer := a.clock.NewTimer(waitFor)
 	defer timer.Stop()
 
-	ticker := time.NewTicker(tick)
+	ticker := a.clock.NewTicker(tick)
 	defer ticker.Stop()
 
-	for tick := ticker.C; ; {
+	for tick := ticker.C(); ; {
 		select {
-		case <-timer.C:
+		case <-timer.C():
 			return true
 		case <-tick:
 			tick = nil
-			go func() { ch <- condition() }()
+			running = goCondition(condition, ch)
 		case v := <-ch:
+			running = nil
 			if v {
 				return a.Fail("Condition satisfied", msgAndArgs...)
 			}
-			tick = ticker.C
+			tick = ticker.C()
 		}
 	}
 }
@@ -1704,6 +2527,14 @@ func (a *Assertions) Never(condition func() bool, waitFor time.Duration, tick ti
 // ErrorIs asserts that at least one of the errors in err's chain matches target.
 // This is a wrapper for errors.Is.
 func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ErrorIs", []any{err, target, msgAndArgs}, func(a *Assertions) bool {
+			return a.ErrorIs(err, target, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1727,6 +2558,14 @@ func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...any) bool {
 // NotErrorIs asserts that at none of the errors in err's chain matches target.
 // This is a wrapper for errors.Is.
 func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NotErrorIs", []any{err, target, msgAndArgs}, func(a *Assertions) bool {
+			return a.NotErrorIs(err, target, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
@@ -1751,6 +2590,14 @@ func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...any) bool {
 // ErrorAs asserts that at least one of the errors in err's chain matches target, and if so, sets target to that error value.
 // This is a wrapper for errors.As.
 func (a *Assertions) ErrorAs(err error, target any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("ErrorAs", []any{err, target, msgAndArgs}, func(a *Assertions) bool {
+			return a.ErrorAs(err, target, msgAndArgs...)
+		})
+	}
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
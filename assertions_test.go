@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !assert_disabled
+
 package assert
 
 import (
@@ -179,6 +181,18 @@ func TestImplements(t *testing.T) {
 	}
 }
 
+func TestNotImplements(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.NotImplements((*AssertionTesterInterface)(nil), new(AssertionTesterNonConformingObject)))
+	New(t).False(mockAssertion.NotImplements((*AssertionTesterInterface)(nil), new(AssertionTesterConformingObject)))
+	New(t).False(mockAssertion.NotImplements((*AssertionTesterInterface)(nil), nil))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).NotImplements((*AssertionTesterInterface)(nil), new(AssertionTesterConformingObject)))
+	New(t).Contains(out.buf.String(), "*assert.AssertionTesterConformingObject implements assert.AssertionTesterInterface")
+}
+
 func TestIsType(t *testing.T) {
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
 	if !mockAssertion.IsType(new(AssertionTesterConformingObject), new(AssertionTesterConformingObject)) {
@@ -189,6 +203,20 @@ func TestIsType(t *testing.T) {
 	}
 }
 
+func TestIsKind(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.IsKind(reflect.Slice, []int{1, 2}))
+	New(t).True(mockAssertion.IsKind(reflect.Map, map[string]int{}))
+	New(t).True(mockAssertion.IsKind(reflect.Ptr, new(AssertionTesterConformingObject)))
+	New(t).False(mockAssertion.IsKind(reflect.Slice, [2]int{1, 2}))
+	New(t).False(mockAssertion.IsKind(reflect.Interface, nil))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).IsKind(reflect.Slice, map[string]int{}))
+	New(t).Contains(out.buf.String(), "Object expected to be of kind slice, but was map (map[string]int)")
+}
+
 func TestEqual(t *testing.T) {
 	type myType string
 
@@ -1003,6 +1031,22 @@ func TestPanicsWithError(t *testing.T) {
 	}
 }
 
+func TestPanicValueRendering(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).PanicsWithValue("boom", func() {
+		panic(errors.New("boom"))
+	}))
+	New(t).Contains(out.buf.String(), "should panic with value:\tboom (string)")
+	New(t).Contains(out.buf.String(), "Panic value:\tboom (*errors.errorString)")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).PanicsWithError("query: boom", func() {
+		panic(fmt.Errorf("query: %w", &customError{}))
+	}))
+	New(t).Contains(out.buf.String(), "Panic value:\tquery: fail (*fmt.wrapError)")
+	New(t).Contains(out.buf.String(), "Panic error chain:\tfail (*assert.customError)")
+}
+
 func TestNotPanics(t *testing.T) {
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
 
@@ -1254,6 +1298,26 @@ func TestGetLen(t *testing.T) {
 	}
 }
 
+func TestValueLen(t *testing.T) {
+	l, ok := ValueLen([]int{1, 2})
+	New(t).True(ok)
+	New(t).Equal(2, l)
+
+	l, ok = ValueLen(42)
+	New(t).False(ok)
+	New(t).Equal(0, l)
+}
+
+func TestIsEmptyValue(t *testing.T) {
+	for _, v := range []any{nil, "", 0, []int{}, map[string]int{}, new(int), struct{}{}} {
+		New(t).Equal(isEmpty(v), IsEmptyValue(v))
+		New(t).True(IsEmptyValue(v), "%#v should be empty", v)
+	}
+	for _, v := range []any{"a", 1, []int{0}, &[]int{1}} {
+		New(t).False(IsEmptyValue(v), "%#v should not be empty", v)
+	}
+}
+
 func TestLen(t *testing.T) {
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
 
@@ -1412,6 +1476,41 @@ func TestInDeltaSlice(t *testing.T) {
 	New(t).False(mockAssertion.InDeltaSlice("", nil, 1), "Expected non numeral slices to fail")
 }
 
+func TestInDelta2D(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.InDelta2D(
+		[][]float64{{1, 2}, {math.NaN(), 4}},
+		[][]float64{{1.05, 1.95}, {math.NaN(), 4}},
+		0.1))
+	New(t).True(mockAssertion.InDelta2D(nil, [][]float64{}, 0))
+	New(t).False(mockAssertion.InDelta2D([][]float64{{1}}, [][]float64{{1}, {2}}, 1))
+	New(t).False(mockAssertion.InDelta2D([][]float64{{1, 2}}, [][]float64{{1}}, 1))
+	New(t).False(mockAssertion.InDelta2D([][]float64{{1}}, [][]float64{{math.NaN()}}, 1))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).InDelta2D(
+		[][]float64{{1, 2}, {3, 4}},
+		[][]float64{{1, 2.5}, {3, 4}},
+		0.1))
+	New(t).Contains(out.buf.String(), "1 cell(s) differ by more than 0.1:")
+	New(t).Contains(out.buf.String(), "(0, 1): expected 2, actual 2.5, difference 0.5")
+
+	expected, actual := make([][]float64, 3), make([][]float64, 3)
+	for i := range expected {
+		expected[i], actual[i] = make([]float64, 5), make([]float64, 5)
+		for j := range actual[i] {
+			actual[i][j] = 1
+		}
+	}
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).InDelta2D(expected, actual, 0.5))
+	New(t).Contains(out.buf.String(), "15 cell(s) differ by more than 0.5:")
+	New(t).Contains(out.buf.String(), "(1, 4): expected 0, actual 1")
+	New(t).NotContains(out.buf.String(), "(2, 0)")
+	New(t).Contains(out.buf.String(), "... and 5 more")
+}
+
 func TestInDeltaMapValues(t *testing.T) {
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
 	assertion := New(t)
@@ -1589,6 +1688,22 @@ func TestRegexp(t *testing.T) {
 	}
 }
 
+func TestRegexpBytesAndStringers(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	log := bytes.NewBufferString("level=error msg=\"connection reset\"\n")
+	New(t).True(mockAssertion.Regexp(`level=error`, log.Bytes()))
+	New(t).True(mockAssertion.Regexp(`level=error`, log))
+	New(t).True(mockAssertion.Regexp([]byte(`msg="[a-z ]+"`), log.Bytes()))
+	New(t).True(mockAssertion.NotRegexp(`level=info`, log.Bytes()))
+	New(t).False(mockAssertion.NotRegexp(regexp.MustCompile(`reset`), log))
+	New(t).True(mockAssertion.ErrorRegexp(errors.New("connection reset"), []byte(`reset$`)))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Regexp([]byte(`^ok`), []byte("failed")))
+	New(t).Contains(out.buf.String(), `Expect "failed" to match "^ok"`)
+}
+
 func testAutogeneratedFunction() {
 	defer func() {
 		if err := recover(); err == nil {
@@ -1635,6 +1750,32 @@ func TestNotZero(t *testing.T) {
 	}
 }
 
+// zeroID is zero when its value is empty, whatever its version.
+type zeroID struct {
+	value   string
+	version int
+}
+
+func (id zeroID) IsZero() bool {
+	return id.value == ""
+}
+
+func TestZeroUsesIsZero(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	utc := time.Time{}.In(time.FixedZone("UTC+1", 3600))
+	New(t).False(reflect.DeepEqual(time.Time{}, utc))
+	New(t).True(mockAssertion.Zero(utc))
+	New(t).False(mockAssertion.NotZero(utc))
+	New(t).True(mockAssertion.Empty(utc))
+	New(t).True(mockAssertion.Empty(&utc))
+	New(t).True(mockAssertion.Zero(zeroID{version: 2}))
+	New(t).False(mockAssertion.Zero(zeroID{value: "a"}))
+	New(t).True(mockAssertion.NotEmpty(zeroID{value: "a"}))
+	New(t).True(mockAssertion.Zero((*time.Time)(nil)))
+	New(t).True(mockAssertion.NotZero(time.Now()))
+}
+
 func TestFileExists(t *testing.T) {
 	assertion := New(t)
 	mockAssertion := NewWithOnFailureNoop(new(testing.T))
@@ -2236,3 +2377,22 @@ func TestIsNil(t *testing.T) {
 		t.Fatal("fail")
 	}
 }
+
+func TestFormatVariants(t *testing.T) {
+	var failures []Failure
+	mockAssertion := NewWithOnFailureNoop(new(testing.T)).AddOnFailure(func(_ TestingT, f Failure) bool {
+		failures = append(failures, f)
+		return true
+	})
+
+	New(t).True(mockAssertion.Equalf(1, 1, "case %d", 1))
+	New(t).False(mockAssertion.Equalf(1, 2, "case %d", 2))
+	New(t).False(mockAssertion.NoErrorf(errors.New("boom"), "stage=%s", "build"))
+	New(t).False(mockAssertion.Containsf("tison", "x", "%d%%", 50))
+
+	New(t).Len(failures, 3)
+	New(t).Equal("Equalf", failures[0].Assertion)
+	New(t).Equal("case 2", failures[0].UserMessage)
+	New(t).Equal("stage=build", failures[1].UserMessage)
+	New(t).Equal("50%", failures[2].UserMessage)
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clock provides a manually driven implementation of assert.Clock,
+// so that time based assertions like Eventually and Never can be tested
+// deterministically.
+//
+//	fake := clock.NewFake(time.Now())
+//	a := assert.New(t).WithClock(fake)
+//	go a.Eventually(ready, time.Minute, time.Second)
+//	fake.BlockUntilTimers(2)
+//	fake.Advance(time.Second)
+package clock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tisonkun/assert"
+)
+
+// Fake is a clock whose time only moves when Advance is called.
+type Fake struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*waiter
+}
+
+// waiter is a timer or, when period is positive, a ticker.
+type waiter struct {
+	clock    *Fake
+	c        chan time.Time
+	deadline time.Time
+	period   time.Duration
+}
+
+// NewFake returns a fake clock set to now.
+func NewFake(now time.Time) *Fake {
+	f := &Fake{now: now}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now returns the current time of the clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// NewTimer creates a timer that fires once the clock is advanced by d.
+func (f *Fake) NewTimer(d time.Duration) assert.Timer {
+	return f.add(d, 0)
+}
+
+// NewTicker creates a ticker that fires every time the clock is advanced
+// by d. Like time.Ticker, it drops ticks when the receiver is slow.
+func (f *Fake) NewTicker(d time.Duration) assert.Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	return ticker{f.add(d, d)}
+}
+
+// Advance moves the clock forward by d and fires the timers and tickers
+// whose deadline has been reached.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+	f.fire()
+}
+
+// BlockUntilTimers blocks until at least n timers and tickers are waiting
+// on the clock. It lets a test wait for the code under test to reach the
+// point where it waits, before advancing the clock.
+func (f *Fake) BlockUntilTimers(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+func (f *Fake) add(d, period time.Duration) *waiter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &waiter{clock: f, c: make(chan time.Time, 1), deadline: f.now.Add(d), period: period}
+	f.waiters = append(f.waiters, w)
+	f.fire()
+	f.cond.Broadcast()
+	return w
+}
+
+// fire must be called with f.mu held.
+func (f *Fake) fire() {
+	active := f.waiters[:0]
+	for _, w := range f.waiters {
+		for !w.deadline.After(f.now) {
+			select {
+			case w.c <- w.deadline:
+			default:
+			}
+			if w.period <= 0 {
+				break
+			}
+			w.deadline = w.deadline.Add(w.period)
+		}
+		if w.period > 0 || w.deadline.After(f.now) {
+			active = append(active, w)
+		}
+	}
+	for i := len(active); i < len(f.waiters); i++ {
+		f.waiters[i] = nil
+	}
+	f.waiters = active
+}
+
+// remove must be called with f.mu held.
+func (f *Fake) remove(w *waiter) bool {
+	for i, other := range f.waiters {
+		if other == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (w *waiter) C() <-chan time.Time {
+	return w.c
+}
+
+func (w *waiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	return w.clock.remove(w)
+}
+
+// ticker adapts a waiter to assert.Ticker.
+type ticker struct {
+	*waiter
+}
+
+func (t ticker) Stop() {
+	t.waiter.Stop()
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tisonkun/assert"
+)
+
+var _ assert.Clock = (*Fake)(nil)
+
+func TestFakeTimer(t *testing.T) {
+	start := time.Date(2022, 12, 21, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+	timer := f.NewTimer(time.Second)
+
+	f.Advance(999 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired too early")
+	default:
+	}
+
+	f.Advance(time.Millisecond)
+	assert.New(t).Equal(start.Add(time.Second), <-timer.C())
+	assert.New(t).False(timer.Stop())
+	assert.New(t).Equal(start.Add(time.Second), f.Now())
+
+	stopped := f.NewTimer(time.Second)
+	assert.New(t).True(stopped.Stop())
+	f.Advance(time.Second)
+	select {
+	case <-stopped.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+}
+
+func TestFakeTicker(t *testing.T) {
+	start := time.Date(2022, 12, 21, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+	ticker := f.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	f.Advance(time.Second)
+	assert.New(t).Equal(start.Add(time.Second), <-ticker.C())
+
+	// Slow receivers miss ticks.
+	f.Advance(3 * time.Second)
+	assert.New(t).Equal(start.Add(2*time.Second), <-ticker.C())
+	select {
+	case <-ticker.C():
+		t.Fatal("ticks should have been dropped")
+	default:
+	}
+
+	assert.New(t).Panics(func() { f.NewTicker(0) })
+}
+
+func TestBlockUntilTimers(t *testing.T) {
+	f := NewFake(time.Now())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f.BlockUntilTimers(2)
+	}()
+
+	f.NewTimer(time.Second)
+	select {
+	case <-done:
+		t.Fatal("BlockUntilTimers returned with one timer")
+	case <-time.After(10 * time.Millisecond):
+	}
+	f.NewTicker(time.Second)
+	<-done
+}
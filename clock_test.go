@@ -0,0 +1,64 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tisonkun/assert"
+	"github.com/tisonkun/assert/clock"
+)
+
+func TestEventuallyWithFakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	a := assert.New(t).WithClock(fake).WithOnFailure(func(assert.TestingT) {})
+
+	var checks int32
+	result := make(chan bool)
+	go func() {
+		result <- a.Eventually(func() bool {
+			return atomic.AddInt32(&checks, 1) == 3
+		}, time.Hour, time.Minute)
+	}()
+
+	// Each check happens after a tick, once the condition of the previous
+	// tick has been evaluated.
+	fake.BlockUntilTimers(2)
+	for i := int32(1); i <= 3; i++ {
+		fake.Advance(time.Minute)
+		for atomic.LoadInt32(&checks) < i {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	assert.New(t).True(<-result)
+}
+
+func TestNeverWithFakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	a := assert.New(t).WithClock(fake).WithOnFailure(func(assert.TestingT) {})
+
+	result := make(chan bool)
+	go func() {
+		result <- a.Never(func() bool { return false }, time.Hour, time.Minute)
+	}()
+
+	fake.BlockUntilTimers(2)
+	fake.Advance(time.Hour)
+	assert.New(t).True(<-result)
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cmpassert provides assertions backed by go-cmp, whose path based
+// diffs read better than unified diffs for deeply nested values.
+//
+//	cmpassert.Equal(a, want, got, cmp.Options{cmpopts.IgnoreFields(User{}, "UpdatedAt")})
+//
+// The options are passed to go-cmp; nil means none.
+package cmpassert
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tisonkun/assert"
+)
+
+type tHelper interface {
+	Helper()
+}
+
+// Equal asserts that expected and actual are equal according to cmp.Equal
+// with the given options, and reports the cmp.Diff of them otherwise.
+func Equal(a *assert.Assertions, expected, actual any, opts cmp.Options, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	diff, err := compare(expected, actual, opts)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Cannot compare values: %v", err), msgAndArgs...)
+	}
+	if diff != "" {
+		return a.Fail(fmt.Sprintf("Not equal (-expected +actual):\n%s", diff), msgAndArgs...)
+	}
+	return true
+}
+
+// NotEqual asserts that expected and actual are not equal according to
+// cmp.Equal with the given options.
+func NotEqual(a *assert.Assertions, expected, actual any, opts cmp.Options, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	diff, err := compare(expected, actual, opts)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Cannot compare values: %v", err), msgAndArgs...)
+	}
+	if diff == "" {
+		return a.Fail(fmt.Sprintf("Should not be: %#v", actual), msgAndArgs...)
+	}
+	return true
+}
+
+// compare returns the cmp.Diff of expected and actual. go-cmp panics on
+// values it cannot compare, e.g. structs with unexported fields and no
+// option to handle them; the panic is returned as an error.
+func compare(expected, actual any, opts cmp.Options) (diff string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return cmp.Diff(expected, actual, opts), nil
+}
+
+// DiffEngine returns an assert.DiffEngine that renders differences with
+// cmp.Diff and the given options, for use with SetDiffEngine or
+// WithDiffEngine. Values go-cmp cannot compare get no diff.
+//
+//	a := assert.New(t).WithDiffEngine(cmpassert.DiffEngine(protocmp.Transform()))
+func DiffEngine(opts ...cmp.Option) assert.DiffEngine {
+	return assert.DiffFunc(func(expected, actual any) string {
+		diff, err := compare(expected, actual, opts)
+		if err != nil || diff == "" {
+			return ""
+		}
+		return "(-expected +actual)\n" + diff
+	})
+}
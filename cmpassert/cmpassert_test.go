@@ -0,0 +1,107 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmpassert
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tisonkun/assert"
+)
+
+type outputT struct {
+	buf     bytes.Buffer
+	helpers map[string]bool
+}
+
+func (t *outputT) Helper() {
+	if t.helpers == nil {
+		t.helpers = map[string]bool{}
+	}
+	pc, _, _, _ := runtime.Caller(1)
+	t.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+func (t *outputT) Errorf(format string, args ...any) {
+	t.buf.WriteString(fmt.Sprintf(format, args...))
+}
+
+func (t *outputT) FailNow() {}
+
+func newRecordingAssertions() (*assert.Assertions, *outputT) {
+	out := &outputT{}
+	return assert.New(out).WithOnFailure(func(assert.TestingT) {}), out
+}
+
+type user struct {
+	Name  string
+	email string
+}
+
+func TestEqual(t *testing.T) {
+	a, _ := newRecordingAssertions()
+
+	assert.New(t).True(Equal(a, []int{1, 2}, []int{1, 2}, nil))
+	assert.New(t).False(Equal(a, []int{1, 2}, []int{1, 3}, nil))
+	assert.New(t).True(Equal(a, "Tison", "tison", cmp.Options{cmp.Comparer(strings.EqualFold)}))
+	assert.New(t).True(Equal(a, user{Name: "tison", email: "a"}, user{Name: "tison", email: "b"}, cmp.Options{cmp.Comparer(func(x, y user) bool {
+		return x.Name == y.Name
+	})}))
+
+	a, out := newRecordingAssertions()
+	assert.New(t).False(Equal(a, struct{ Tags []string }{[]string{"a", "b"}}, struct{ Tags []string }{[]string{"a", "c"}}, nil, "user %s", "tison"))
+	assert.New(t).Contains(out.buf.String(), "Not equal (-expected +actual):")
+	assert.New(t).Contains(out.buf.String(), `"b"`)
+	assert.New(t).Contains(out.buf.String(), `"c"`)
+	assert.New(t).Contains(out.buf.String(), "user tison")
+
+	a, out = newRecordingAssertions()
+	assert.New(t).False(Equal(a, user{}, user{}, nil))
+	assert.New(t).Contains(out.buf.String(), "Cannot compare values:")
+	assert.New(t).Contains(out.buf.String(), "unexported field")
+}
+
+func TestNotEqual(t *testing.T) {
+	a, _ := newRecordingAssertions()
+
+	assert.New(t).True(NotEqual(a, 1, 2, nil))
+	assert.New(t).False(NotEqual(a, 1, 1, nil))
+	assert.New(t).False(NotEqual(a, "Tison", "tison", cmp.Options{cmp.Comparer(strings.EqualFold)}))
+}
+
+func TestHelpers(t *testing.T) {
+	a, out := newRecordingAssertions()
+	Equal(a, 1, 2, nil)
+	NotEqual(a, 1, 1, nil)
+	assert.New(t).True(out.helpers["github.com/tisonkun/assert/cmpassert.Equal"])
+	assert.New(t).True(out.helpers["github.com/tisonkun/assert/cmpassert.NotEqual"])
+}
+
+func TestDiffEngine(t *testing.T) {
+	out := &outputT{}
+	a := assert.New(out).WithOnFailure(func(assert.TestingT) {}).WithDiffEngine(DiffEngine())
+	assert.New(t).False(a.Equal(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}))
+	assert.New(t).Contains(out.buf.String(), "Diff:")
+	assert.New(t).Contains(out.buf.String(), "(-expected +actual)")
+	assert.New(t).Contains(out.buf.String(), `"b": 2,`)
+	assert.New(t).Contains(out.buf.String(), `"b": 3,`)
+	assert.New(t).NotContains(out.buf.String(), "--- Expected")
+
+	assert.New(t).Equal("", DiffEngine().Diff(user{}, user{Name: "tison"}))
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"math"
+	"reflect"
+)
+
+// WithConvertibleStructs returns a new Assertions whose EqualValues and
+// NotEqualValues also consider structs of different defined types equal
+// when they have identical layouts: the same field names in the same order
+// and equal field values, recursively through pointers, slices, arrays and
+// maps. This suits mapping layers where a DTO and a domain type are
+// generated from the same schema.
+//
+//	a.WithConvertibleStructs().EqualValues(dto, toDomain(dto))
+func (a *Assertions) WithConvertibleStructs() *Assertions {
+	c := *a
+	c.convertibleStructs = true
+	return &c
+}
+
+// objectsAreEqualValues is ObjectsAreEqualValues, extended to structs with
+// identical layouts if enabled with WithConvertibleStructs.
+func (a *Assertions) objectsAreEqualValues(expected, actual any) bool {
+	if ObjectsAreEqualValues(expected, actual) {
+		return true
+	}
+	return a.convertibleStructs && expected != nil && actual != nil &&
+		layoutsEqual(reflect.ValueOf(expected), reflect.ValueOf(actual), 0)
+}
+
+// maxLayoutDepth bounds the recursion of layoutsEqual on cyclic values.
+const maxLayoutDepth = 64
+
+// layoutsEqual reports whether e and a are deeply equal, treating values of
+// different types as equal when their kinds match and, for structs, when
+// their field names match in order.
+func layoutsEqual(e, a reflect.Value, depth int) bool {
+	if !e.IsValid() || !a.IsValid() {
+		return e.IsValid() == a.IsValid()
+	}
+	if e.Kind() != a.Kind() || depth > maxLayoutDepth {
+		return false
+	}
+	if e.Type() == a.Type() && e.CanInterface() && a.CanInterface() {
+		return ObjectsAreEqual(e.Interface(), a.Interface())
+	}
+
+	switch e.Kind() {
+	case reflect.Bool:
+		return e.Bool() == a.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return e.Int() == a.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return e.Uint() == a.Uint()
+	case reflect.Float32, reflect.Float64:
+		return e.Float() == a.Float() || math.IsNaN(e.Float()) && math.IsNaN(a.Float())
+	case reflect.Complex64, reflect.Complex128:
+		return e.Complex() == a.Complex()
+	case reflect.String:
+		return e.String() == a.String()
+	case reflect.Ptr, reflect.Interface:
+		if e.IsNil() || a.IsNil() {
+			return e.IsNil() && a.IsNil()
+		}
+		return layoutsEqual(e.Elem(), a.Elem(), depth+1)
+	case reflect.Slice:
+		if e.IsNil() != a.IsNil() {
+			return false
+		}
+		fallthrough
+	case reflect.Array:
+		if e.Len() != a.Len() {
+			return false
+		}
+		for i := 0; i < e.Len(); i++ {
+			if !layoutsEqual(e.Index(i), a.Index(i), depth+1) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if e.IsNil() != a.IsNil() || e.Len() != a.Len() {
+			return false
+		}
+		keyType := a.Type().Key()
+		if !e.Type().Key().ConvertibleTo(keyType) {
+			return false
+		}
+		iter := e.MapRange()
+		for iter.Next() {
+			v := a.MapIndex(iter.Key().Convert(keyType))
+			if !v.IsValid() || !layoutsEqual(iter.Value(), v, depth+1) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		if e.NumField() != a.NumField() {
+			return false
+		}
+		for i := 0; i < e.NumField(); i++ {
+			if e.Type().Field(i).Name != a.Type().Field(i).Name ||
+				!layoutsEqual(e.Field(i), a.Field(i), depth+1) {
+				return false
+			}
+		}
+		return true
+	default:
+		// functions, channels and unsafe pointers of different types
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+type addressDTO struct {
+	Street string `json:"street"`
+	Zip    int
+}
+
+type userDTO struct {
+	Name      string `json:"name"`
+	Addresses []addressDTO
+	Primary   *addressDTO
+	Tags      map[string]addressDTO
+	secret    int
+}
+
+type address struct {
+	Street string
+	Zip    int
+}
+
+type user struct {
+	Name      string
+	Addresses []address
+	Primary   *address
+	Tags      map[string]address
+	secret    int
+}
+
+func TestWithConvertibleStructs(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	convertible := mockAssertion.WithConvertibleStructs()
+
+	dto := userDTO{
+		Name:      "tison",
+		Addresses: []addressDTO{{"Main St", 1}},
+		Primary:   &addressDTO{"Main St", 1},
+		Tags:      map[string]addressDTO{"home": {"Main St", 1}},
+		secret:    7,
+	}
+	domain := user{
+		Name:      "tison",
+		Addresses: []address{{"Main St", 1}},
+		Primary:   &address{"Main St", 1},
+		Tags:      map[string]address{"home": {"Main St", 1}},
+		secret:    7,
+	}
+
+	New(t).False(mockAssertion.EqualValues(dto, domain))
+	New(t).True(convertible.EqualValues(dto, domain))
+	New(t).True(convertible.EqualValues(&dto, &domain))
+	New(t).False(convertible.NotEqualValues(dto, domain))
+	New(t).True(convertible.EqualValues(addressDTO{"a", 1}, address{"a", 1}))
+	New(t).True(convertible.EqualValues(int32(1), int64(1)))
+
+	changed := domain
+	changed.secret = 8
+	New(t).False(convertible.EqualValues(dto, changed))
+	changed = domain
+	changed.Primary = nil
+	New(t).False(convertible.EqualValues(dto, changed))
+	changed = domain
+	changed.Addresses = nil
+	New(t).False(convertible.EqualValues(dto, changed))
+	changed = domain
+	changed.Tags = map[string]address{"work": {"Main St", 1}}
+	New(t).False(convertible.EqualValues(dto, changed))
+	New(t).True(convertible.NotEqualValues(dto, changed))
+
+	type renamed struct {
+		Road string
+		Zip  int
+	}
+	New(t).False(convertible.EqualValues(addressDTO{"a", 1}, renamed{"a", 1}))
+	New(t).False(convertible.EqualValues(addressDTO{"a", 1}, nil))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithConvertibleStructs().EqualValues(addressDTO{"a", 1}, address{"a", 2}))
+	New(t).Contains(out.buf.String(), "Not equal:")
+}
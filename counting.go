@@ -0,0 +1,158 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// testCounters maps the TestingTs passed to ExpectAssertions to their
+// assertion counters, which New attaches to the Assertions it makes.
+var (
+	testCounters      sync.Map
+	testCountersInUse int32
+)
+
+// ExpectAssertions fails the test at its end unless exactly n assertions
+// were made for t. It catches assertions in callbacks that silently never
+// run. All Assertions made with New(t) after the call count, including
+// those of the require package, but not those of subtests.
+//
+//	assert.ExpectAssertions(t, 2)
+//	server.OnRequest(func(r *http.Request) {
+//		require.Equal(t, "POST", r.Method)
+//		require.NotEmpty(t, r.Header.Get("Authorization"))
+//	})
+//
+// ExpectAssertions needs a TestingT with a Cleanup method, e.g. *testing.T.
+func ExpectAssertions(t TestingT, n int) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	a := New(t)
+	if !reflect.TypeOf(t).Comparable() {
+		a.Fail(fmt.Sprintf("ExpectAssertions needs a comparable TestingT, but %T is not", t))
+		return
+	}
+	counter := new(int64)
+	if _, loaded := testCounters.LoadOrStore(t, counter); loaded {
+		a.Fail("ExpectAssertions called twice for the same test")
+		return
+	}
+	atomic.AddInt32(&testCountersInUse, 1)
+	a.expectCount(n, counter, func() {
+		testCounters.Delete(t)
+		atomic.AddInt32(&testCountersInUse, -1)
+	})
+}
+
+// AssertionCount returns the number of assertions made for t since
+// ExpectAssertions, or zero if ExpectAssertions was not called for t.
+func AssertionCount(t TestingT) int {
+	if counter := testCounter(t); counter != nil {
+		return int(atomic.LoadInt64(counter))
+	}
+	return 0
+}
+
+// testCounter returns the counter registered for t with ExpectAssertions,
+// or nil.
+func testCounter(t TestingT) *int64 {
+	if atomic.LoadInt32(&testCountersInUse) == 0 || t == nil || !reflect.TypeOf(t).Comparable() {
+		return nil
+	}
+	if counter, ok := testCounters.Load(t); ok {
+		return counter.(*int64)
+	}
+	return nil
+}
+
+// Counting returns a new Assertions that counts the assertions made through
+// it and the Assertions derived from it, see AssertionCount. Assertions
+// built on other assertions count once.
+func (a *Assertions) Counting() *Assertions {
+	if a.counter != nil {
+		return a
+	}
+	return a.countingWith(new(int64))
+}
+
+// countingWith returns a copy of the Assertions that counts its assertions
+// with counter. Counting is an interceptor since every assertion, generic
+// ones included, dispatches through intercept once there is one.
+func (a *Assertions) countingWith(counter *int64) *Assertions {
+	c := a.Use(func(next AssertionCall) AssertionCall {
+		return func(call Call) bool {
+			atomic.AddInt64(counter, 1)
+			return next(call)
+		}
+	})
+	c.counter = counter
+	return c
+}
+
+// AssertionCount returns the number of assertions made through the
+// Assertions since Counting or ExpectAssertions, or zero if it does not
+// count.
+func (a *Assertions) AssertionCount() int {
+	if a.counter == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(a.counter))
+}
+
+// ExpectAssertions returns a new counting Assertions, and fails the test at
+// its end unless exactly n assertions were made through it. Unlike the
+// package level ExpectAssertions, only the returned Assertions and those
+// derived from it count.
+//
+//	a := assert.New(t).ExpectAssertions(2)
+//	server.OnRequest(func(r *http.Request) {
+//		a.Equal("POST", r.Method)
+//		a.NotEmpty(r.Header.Get("Authorization"))
+//	})
+//
+// ExpectAssertions needs a TestingT with a Cleanup method, e.g. *testing.T.
+func (a *Assertions) ExpectAssertions(n int) *Assertions {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	c := a.Counting()
+	a.expectCount(n, c.counter, func() {})
+	return c
+}
+
+// expectCount registers a check that counter is n at the end of the test,
+// which runs done afterwards.
+func (a *Assertions) expectCount(n int, counter *int64, done func()) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	cl, ok := a.t.(cleaner)
+	if !ok {
+		done()
+		a.Fail("ExpectAssertions needs a TestingT with a Cleanup method")
+		return
+	}
+	cl.Cleanup(func() {
+		defer done()
+		if count := int(atomic.LoadInt64(counter)); count != n {
+			a.Fail(fmt.Sprintf("Expected %d assertion(s) to run, but %d did", n, count))
+		}
+	})
+}
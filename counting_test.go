@@ -0,0 +1,91 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCounting(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	New(t).Equal(0, mockAssertion.AssertionCount())
+
+	counting := mockAssertion.Counting()
+	New(t).Same(counting, counting.Counting())
+	counting.Equal(1, 1)
+	counting.Exactly(1, 2)
+	counting.WithJSONPatch().True(true)
+	counting.That("tison").HasLen(5).Contains("t")
+	New(t).Equal(5, counting.AssertionCount())
+	New(t).Equal(0, mockAssertion.AssertionCount())
+}
+
+func TestExpectAssertions(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a := NewWithOnFailureNoop(out).ExpectAssertions(2)
+	a.True(true)
+	a.NotNil(a)
+	out.runCleanups()
+	New(t).Equal(0, out.buf.Len())
+
+	out = &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a = NewWithOnFailureNoop(out).ExpectAssertions(2)
+	neverCalled := func() { a.True(true) }
+	_ = neverCalled
+	a.True(true)
+	out.runCleanups()
+	New(t).Contains(out.buf.String(), "Expected 2 assertion(s) to run, but 1 did")
+
+	plain := &outputT{buf: bytes.NewBuffer(nil)}
+	NewWithOnFailureNoop(plain).ExpectAssertions(1)
+	New(t).Contains(plain.buf.String(), "ExpectAssertions needs a TestingT with a Cleanup method")
+}
+
+func TestCountingGenericAssertions(t *testing.T) {
+	counting := NewWithOnFailureNoop(new(testing.T)).Counting()
+	counting.DeepContains([]int{1}, 1)
+	EqualT(counting, 1, 1)
+	Match(counting, 1, func(int) bool { return true }, "is anything")
+	New(t).Equal(3, counting.AssertionCount())
+}
+
+func TestExpectAssertionsForTest(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	ExpectAssertions(out, 3)
+	New(t).Equal(0, AssertionCount(out))
+	a := NewWithOnFailureNoop(out)
+	a.True(true)
+	EqualT(NewWithOnFailureNoop(out), 1, 1)
+	New(t).Equal(2, AssertionCount(out))
+	New(t).Equal(2, a.AssertionCount())
+	out.runCleanups()
+	New(t).Contains(out.buf.String(), "Expected 3 assertion(s) to run, but 2 did")
+	New(t).Equal(0, AssertionCount(out))
+	New(t).Equal(0, NewWithOnFailureNoop(out).AssertionCount(), "the expectation ends with the test")
+
+	out = &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	ExpectAssertions(out, 1)
+	NewWithOnFailureNoop(out).Nil(nil)
+	out.runCleanups()
+	New(t).Equal(0, out.buf.Len())
+
+	plain := &outputT{buf: bytes.NewBuffer(nil)}
+	ExpectAssertions(plain, 1)
+	New(t).Contains(plain.buf.String(), "ExpectAssertions needs a TestingT with a Cleanup method")
+	New(t).Equal(0, AssertionCount(plain))
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Deferred returns a new Assertions that buffers its failures instead of
+// reporting them right away. At the end of the test, the buffered failures
+// are reported at once, grouped by the assertion that failed, with a count
+// header. Failures do not stop the test.
+//
+// Deferred needs a TestingT with a Cleanup method, e.g. *testing.T; with
+// other TestingT values failures are reported immediately. Assertions
+// derived from the returned one, e.g. by RunTable, share its buffer.
+//
+//	a := assert.New(t).Deferred()
+//	for _, c := range cases {
+//		a.Equal(c.want, Compute(c.input), "input %v", c.input)
+//	}
+func (a *Assertions) Deferred() *Assertions {
+	c, ok := a.t.(cleaner)
+	if !ok {
+		return a
+	}
+	d := &deferredFailures{}
+	c.Cleanup(func() {
+		if report := d.report(); report != "" {
+			a.t.Errorf("\n%s", report)
+		}
+	})
+	cp := *a
+	cp.deferred = d
+	return &cp
+}
+
+// deferredFailures buffers formatted failures by the assertion that failed.
+// It is safe for concurrent use.
+type deferredFailures struct {
+	mu     sync.Mutex
+	groups []string
+	byName map[string][]string
+	count  int
+}
+
+func (d *deferredFailures) add(assertion, output string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.byName == nil {
+		d.byName = make(map[string][]string)
+	}
+	if _, ok := d.byName[assertion]; !ok {
+		d.groups = append(d.groups, assertion)
+	}
+	d.byName[assertion] = append(d.byName[assertion], output)
+	d.count++
+}
+
+// take renders the buffered failures like report and clears the buffer.
+func (d *deferredFailures) take() string {
+	report := d.report()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.groups, d.byName, d.count = nil, nil, 0
+	return report
+}
+
+// report renders the buffered failures grouped in the order their groups
+// first failed, or returns an empty string if nothing failed.
+func (d *deferredFailures) report() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.count == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d deferred failure(s) in %d group(s):\n", d.count, len(d.groups))
+	for _, group := range d.groups {
+		failures := d.byName[group]
+		fmt.Fprintf(&b, "\n%s: %d failure(s)\n", group, len(failures))
+		for _, failure := range failures {
+			b.WriteString(failure)
+		}
+	}
+	return b.String()
+}
+
+// failedAssertion returns the name of the outermost assertion of this
+// module on the stack of the calling Fail, e.g. "JSONRoundTrips" when it
+// failed through RoundTrips, or "AssertExpectations" of a mock.
+func failedAssertion() string {
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(3, pc)])
+	name := "Fail"
+	for {
+		frame, more := frames.Next()
+		if !internalFrame(frame) {
+			break
+		}
+		if exported := exportedName(frame.Function); exported != "" {
+			name = exported
+		}
+		if !more {
+			break
+		}
+	}
+	return name
+}
+
+// exportedName returns the innermost exported identifier of a function name
+// without its package, receiver, type arguments and closure suffixes, e.g.
+// "Concurrently" for "(*Assertions).Concurrently.func1", or "" if there is
+// none.
+func exportedName(function string) string {
+	f := strings.TrimPrefix(function, funcPackage(function)+".")
+	if strings.HasPrefix(f, "(") {
+		if i := strings.Index(f, ")."); i > 0 {
+			f = f[i+2:]
+		}
+	}
+	if i := strings.IndexByte(f, '['); i > 0 {
+		f = f[:i]
+	}
+	segments := strings.Split(f, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if r, _ := utf8.DecodeRuneInString(segments[i]); unicode.IsUpper(r) {
+			return segments[i]
+		}
+	}
+	return ""
+}
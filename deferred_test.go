@@ -0,0 +1,140 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDeferred(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	stopped := 0
+	a := New(out).WithOnFailure(func(TestingT) { stopped++ }).Deferred()
+
+	for i := 0; i < 3; i++ {
+		a.Equal(i, 1, "case %d", i)
+	}
+	a.JSONRoundTrips(make(chan int))
+	a.True(true)
+	New(t).Equal(0, out.buf.Len())
+	New(t).Equal(0, stopped)
+
+	out.runCleanups()
+	report := out.buf.String()
+	New(t).Contains(report, "3 deferred failure(s) in 2 group(s):")
+	New(t).Contains(report, "\nEqual: 2 failure(s)\n")
+	New(t).Contains(report, "\nJSONRoundTrips: 1 failure(s)\n")
+	New(t).Less(strings.Index(report, "case 0"), strings.Index(report, "case 2"))
+	New(t).NotContains(report, "case 1")
+	New(t).Less(strings.Index(report, "Equal:"), strings.Index(report, "JSONRoundTrips:"))
+}
+
+func TestDeferredNoFailures(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a := New(out).Deferred()
+	a.True(true)
+	out.runCleanups()
+	New(t).Equal(0, out.buf.Len())
+}
+
+func TestDeferredWithoutCleanup(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out)
+	New(t).Same(a, a.Deferred())
+}
+
+func TestDeferredNestedChecks(t *testing.T) {
+	equal := func(expected any) ValueAssertionFunc {
+		return func(a *Assertions, actual any, msgAndArgs ...any) bool {
+			return a.Equal(expected, actual, msgAndArgs...)
+		}
+	}
+	for name, check := range map[string]func(a *Assertions) bool{
+		"ForAll": func(a *Assertions) bool {
+			return a.ForAll(func(r *rand.Rand) any {
+				return r.Intn(100) + 1
+			}, func(a *Assertions, v any) {
+				a.Equal(-1, v)
+			}, ForAllRuns(10))
+		},
+		"All": func(a *Assertions) bool {
+			return All(equal(1), equal(2))(a, 1)
+		},
+		"Any": func(a *Assertions) bool {
+			return Any(equal(2), equal(3))(a, 1)
+		},
+		"EveryElement": func(a *Assertions) bool {
+			return a.EveryElement([]int{1, 2}, func(a *Assertions, el any) {
+				a.Equal(1, el)
+			})
+		},
+	} {
+		for mode, derive := range map[string]func(a *Assertions) *Assertions{
+			"Deferred": (*Assertions).Deferred,
+			"Soft": func(a *Assertions) *Assertions {
+				return a.Soft().Assertions
+			},
+		} {
+			out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+			New(t).False(check(derive(NewWithOnFailureNoop(out))), "%s under %s", name, mode)
+			New(t).Equal(0, out.buf.Len(), "%s under %s", name, mode)
+
+			out.runCleanups()
+			report := out.buf.String()
+			New(t).Contains(report, "1 deferred failure(s) in 1 group(s):", "%s under %s", name, mode)
+			New(t).Contains(report, "\n"+name+": 1 failure(s)\n", "%s under %s", name, mode)
+			if name == "ForAll" {
+				New(t).Contains(report, "shrunk: 0", "%s under %s", name, mode)
+			}
+		}
+	}
+
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	New(t).True(Any(equal(2), equal(1))(New(out).Deferred(), 1))
+	out.runCleanups()
+	New(t).Equal(0, out.buf.Len())
+}
+
+func TestFailedAssertion(t *testing.T) {
+	d := &deferredFailures{}
+	a := NewWithOnFailureNoop(new(testing.T))
+	a.deferred = d
+
+	a.Fail("direct")
+	a.EqualValues(1, 2)
+	Match(a, 1, func(int) bool { return false }, "never")
+	New(t).Equal([]string{"Fail", "EqualValues", "Match"}, d.groups)
+}
+
+func TestExportedName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"github.com/tisonkun/assert.(*Assertions).Equal":              "Equal",
+		"github.com/tisonkun/assert.(*Assertions).Concurrently.func1": "Concurrently",
+		"github.com/tisonkun/assert.EqualT[...]":                      "EqualT",
+		"github.com/tisonkun/assert.mustNoError":                      "",
+		"github.com/tisonkun/assert/require.Equal":                    "Equal",
+		"github.com/tisonkun/assert/mock.(*Mock).AssertExpectations":  "AssertExpectations",
+		"github.com/tisonkun/assert/mock.(*Spy[...]).CalledWith":      "CalledWith",
+		"github.com/tisonkun/assert/promassert.MetricEquals":          "MetricEquals",
+		"github.com/tisonkun/assert/cmpassert.Equal":                  "Equal",
+	} {
+		New(t).Equal(expected, exportedName(name), name)
+	}
+}
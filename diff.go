@@ -0,0 +1,128 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// DiffOption configures Diff.
+type DiffOption func(*diffConfig)
+
+type diffConfig struct {
+	context  int
+	maxDepth int
+	renderer func(v any) string
+}
+
+// DiffContext sets the number of unchanged lines shown around each change.
+// The default is 1.
+func DiffContext(lines int) DiffOption {
+	return func(c *diffConfig) {
+		c.context = lines
+	}
+}
+
+// DiffMaxDepth sets how deep nested values are rendered by the default
+// renderer; deeper values are elided. The default is 10, and zero means no
+// limit.
+func DiffMaxDepth(depth int) DiffOption {
+	return func(c *diffConfig) {
+		c.maxDepth = depth
+	}
+}
+
+// DiffRenderer replaces the default renderer, which dumps values with spew,
+// with a function that renders a value as the text to diff.
+func DiffRenderer(render func(v any) string) DiffOption {
+	return func(c *diffConfig) {
+		c.renderer = render
+	}
+}
+
+// Diff returns a unified diff of expected and actual as rendered for
+// failure messages, as long as both are of the same type and are a struct,
+// map, slice, array or string. Otherwise, or if their renderings are equal,
+// it returns an empty string. Slices and arrays of 1000 elements or more
+// are diffed element by element, and their hunk ranges count elements
+// instead of lines. Custom assertions can use it to include diffs in their
+// own failure messages.
+//
+//	if d := assert.Diff(want, got, assert.DiffContext(3)); d != "" {
+//		t.Errorf("unexpected config:\n%s", d)
+//	}
+func Diff(expected, actual any, opts ...DiffOption) string {
+	config := diffConfig{context: 1, maxDepth: spewConfig.MaxDepth}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if expected == nil || actual == nil {
+		return ""
+	}
+
+	et, ek := typeAndKind(expected)
+	at, _ := typeAndKind(actual)
+
+	if et != at {
+		return ""
+	}
+
+	if ek != reflect.Struct && ek != reflect.Map && ek != reflect.Slice && ek != reflect.Array && ek != reflect.String {
+		return ""
+	}
+
+	if config.renderer == nil && (ek == reflect.Slice || ek == reflect.Array) && et.Elem().Kind() != reflect.Uint8 {
+		if reflect.ValueOf(expected).Len() >= largeSliceDiffThreshold || reflect.ValueOf(actual).Len() >= largeSliceDiffThreshold {
+			return sliceDiff(expected, actual, config)
+		}
+	}
+
+	var e, a string
+
+	switch {
+	case config.renderer != nil:
+		e = config.renderer(expected)
+		a = config.renderer(actual)
+	case et == reflect.TypeOf(""):
+		e = reflect.ValueOf(expected).String()
+		a = reflect.ValueOf(actual).String()
+	case et == reflect.TypeOf(time.Time{}):
+		c := spewConfigStringerEnabled
+		c.MaxDepth = config.maxDepth
+		e = c.Sdump(expected)
+		a = c.Sdump(actual)
+	default:
+		c := spewConfig
+		c.MaxDepth = config.maxDepth
+		e = c.Sdump(expected)
+		a = c.Sdump(actual)
+	}
+
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(e),
+		B:        difflib.SplitLines(a),
+		FromFile: "Expected",
+		FromDate: "",
+		ToFile:   "Actual",
+		ToDate:   "",
+		Context:  config.context,
+	})
+
+	return diff
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import "sync/atomic"
+
+// DiffEngine renders the difference between an expected and an actual
+// value in failure messages of assertions like Equal. Diff returns an empty
+// string when it has nothing useful to show, e.g. for values of different
+// types.
+type DiffEngine interface {
+	Diff(expected, actual any) string
+}
+
+// DiffFunc adapts an ordinary function to a DiffEngine.
+type DiffFunc func(expected, actual any) string
+
+// Diff calls f(expected, actual).
+func (f DiffFunc) Diff(expected, actual any) string {
+	return f(expected, actual)
+}
+
+// UnifiedDiff is the default DiffEngine. It renders a unified diff of the
+// values dumped by spew, see Diff.
+var UnifiedDiff DiffEngine = DiffFunc(func(expected, actual any) string {
+	return Diff(expected, actual)
+})
+
+// diffEngineHolder wraps DiffEngine values so that they have a single
+// concrete type, as atomic.Value requires.
+type diffEngineHolder struct {
+	engine DiffEngine
+}
+
+var globalDiffEngine atomic.Value
+
+// SetDiffEngine sets the DiffEngine used by every Assertions that has none
+// set with WithDiffEngine. A nil engine restores UnifiedDiff.
+func SetDiffEngine(engine DiffEngine) {
+	globalDiffEngine.Store(diffEngineHolder{engine})
+}
+
+// WithDiffEngine returns a new Assertions that renders differences with the
+// given engine. A nil engine falls back to the one set with SetDiffEngine.
+func (a *Assertions) WithDiffEngine(engine DiffEngine) *Assertions {
+	c := *a
+	c.diffEngine = engine
+	return &c
+}
+
+// diff renders the difference of expected and actual with the DiffEngine
+// in effect, as a block to append to a failure message.
+func (a *Assertions) diff(expected, actual any) string {
+	if d := a.rawDiff(expected, actual); d != "" {
+		return "\n\nDiff:\n" + d
+	}
+	return ""
+}
+
+// rawDiff renders the difference of expected and actual with the
+// DiffEngine in effect, or returns "" if there is none to show.
+func (a *Assertions) rawDiff(expected, actual any) string {
+	engine := a.diffEngine
+	if engine == nil {
+		if holder, ok := globalDiffEngine.Load().(diffEngineHolder); ok {
+			engine = holder.engine
+		}
+	}
+	if engine == nil {
+		engine = UnifiedDiff
+	}
+	if d := engine.Diff(expected, actual); d != "" {
+		return a.formatDiff(d)
+	}
+	return ""
+}
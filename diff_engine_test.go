@@ -0,0 +1,53 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestDiffEngine(t *testing.T) {
+	typeOnly := DiffFunc(func(expected, actual any) string {
+		return fmt.Sprintf("%T vs %T", expected, actual)
+	})
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Equal([]int{1}, []int{2}))
+	New(t).Contains(out.buf.String(), "--- Expected")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithDiffEngine(typeOnly).Equal([]int{1}, []int{2}))
+	New(t).Contains(out.buf.String(), "Diff:\n\t            \t[]int vs []int")
+	New(t).NotContains(out.buf.String(), "--- Expected")
+
+	SetDiffEngine(typeOnly)
+	defer SetDiffEngine(nil)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).EqualValues([]int{1}, []int{2}))
+	New(t).Contains(out.buf.String(), "[]int vs []int")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithDiffEngine(UnifiedDiff).Equal([]int{1}, []int{2}))
+	New(t).Contains(out.buf.String(), "--- Expected")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithDiffEngine(DiffFunc(func(any, any) string { return "" })).Equal(1, 2))
+	New(t).NotContains(out.buf.String(), "Diff:")
+}
@@ -0,0 +1,261 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	// largeSliceDiffThreshold is the length from which slices and arrays are
+	// diffed element by element instead of line by line.
+	largeSliceDiffThreshold = 1000
+	// maxSliceDiffElements caps the length of slices that are diffed at all.
+	maxSliceDiffElements = 1 << 20
+	// maxSliceDiffEdits bounds the search for the shortest edit script. Past
+	// it, the differing middle is shown as a replacement.
+	maxSliceDiffEdits = 1000
+	// maxSliceDiffRendered caps the number of elements rendered in a diff.
+	maxSliceDiffRendered = 1000
+)
+
+// sliceEdit is one step of an edit script over elements: ' ' keeps
+// expected[a], '-' deletes expected[a] and '+' inserts actual[b].
+type sliceEdit struct {
+	kind byte
+	a, b int
+}
+
+// sliceDiff renders a unified diff of two large slices or arrays, computed
+// over their elements rather than over the lines of their dumps. Hunk
+// ranges count elements.
+func sliceDiff(expected, actual any, config diffConfig) string {
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if ev.Len() > maxSliceDiffElements || av.Len() > maxSliceDiffElements {
+		return ""
+	}
+
+	c := spewConfig
+	c.MaxDepth = config.maxDepth
+	ids := map[string]int{}
+	var lines []string
+	keys := func(v reflect.Value) []int {
+		k := make([]int, v.Len())
+		for i := range k {
+			s := strings.TrimSuffix(c.Sdump(v.Index(i).Interface()), "\n") + ","
+			id, ok := ids[s]
+			if !ok {
+				id = len(lines)
+				ids[s] = id
+				lines = append(lines, s)
+			}
+			k[i] = id
+		}
+		return k
+	}
+	a, b := keys(ev), keys(av)
+
+	edits := sliceEditScript(a, b)
+	render := func(e sliceEdit) string {
+		if e.kind == '+' {
+			return lines[b[e.b]]
+		}
+		return lines[a[e.a]]
+	}
+	return formatSliceEdits(edits, render, config.context)
+}
+
+// sliceEditScript returns an edit script turning a into b. It is the
+// shortest one unless that takes more than maxSliceDiffEdits edits.
+func sliceEditScript(a, b []int) []sliceEdit {
+	var edits []sliceEdit
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		edits = append(edits, sliceEdit{' ', prefix, prefix})
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	middle, ok := myersDiff(ma, mb, maxSliceDiffEdits)
+	if !ok {
+		middle = middle[:0]
+		for i := range ma {
+			middle = append(middle, sliceEdit{'-', i, 0})
+		}
+		for j := range mb {
+			middle = append(middle, sliceEdit{'+', len(ma), j})
+		}
+	}
+	for _, e := range middle {
+		edits = append(edits, sliceEdit{e.kind, e.a + prefix, e.b + prefix})
+	}
+
+	for i := suffix; i > 0; i-- {
+		edits = append(edits, sliceEdit{' ', len(a) - i, len(b) - i})
+	}
+	return edits
+}
+
+// myersDiff computes the shortest edit script turning a into b with Myers'
+// algorithm. It gives up and returns false past maxEdits edits.
+func myersDiff(a, b []int, maxEdits int) ([]sliceEdit, bool) {
+	n, m := len(a), len(b)
+	limit := n + m
+	if limit > maxEdits {
+		limit = maxEdits
+	}
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	// trace[d] holds the furthest x on diagonals -d..d after d edits.
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[offset+k-1] < v[offset+k+1] {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return myersBacktrack(trace, d, n, m), true
+			}
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+	}
+	return nil, false
+}
+
+// myersBacktrack walks the trace of myersDiff back from (n, m) to collect
+// the edit script found after d edits.
+func myersBacktrack(trace [][]int, d, n, m int) []sliceEdit {
+	var edits []sliceEdit
+	x, y := n, m
+	for ; d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+		k := x - y
+		prevK := k - 1
+		if k == -d || k != d && at(k-1) < at(k+1) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, sliceEdit{' ', x, y})
+		}
+		if prevK == k+1 {
+			edits = append(edits, sliceEdit{'+', x, prevY})
+		} else {
+			edits = append(edits, sliceEdit{'-', prevX, y})
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 {
+		x--
+		y--
+		edits = append(edits, sliceEdit{' ', x, y})
+	}
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
+
+// formatSliceEdits renders edits as unified diff hunks with the given
+// number of context elements, or an empty string if nothing changed.
+func formatSliceEdits(edits []sliceEdit, render func(sliceEdit) string, context int) string {
+	// hunks are [start, end) ranges of edits around the changes
+	var hunks [][2]int
+	for i, e := range edits {
+		if e.kind == ' ' {
+			continue
+		}
+		start, end := i-context, i+context+1
+		if start < 0 {
+			start = 0
+		}
+		if end > len(edits) {
+			end = len(edits)
+		}
+		if last := len(hunks) - 1; last >= 0 && start <= hunks[last][1] {
+			hunks[last][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+	}
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var buf strings.Builder
+	buf.WriteString("--- Expected\n+++ Actual\n")
+	rendered := 0
+	for _, hunk := range hunks {
+		aCount, bCount := 0, 0
+		for _, e := range edits[hunk[0]:hunk[1]] {
+			if e.kind != '+' {
+				aCount++
+			}
+			if e.kind != '-' {
+				bCount++
+			}
+		}
+		first := edits[hunk[0]]
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", unifiedRange(first.a, aCount), unifiedRange(first.b, bCount))
+		for i, e := range edits[hunk[0]:hunk[1]] {
+			if rendered == maxSliceDiffRendered {
+				changes := 0
+				for _, e := range edits[hunk[0]+i:] {
+					if e.kind != ' ' {
+						changes++
+					}
+				}
+				fmt.Fprintf(&buf, "... diff truncated, %d more changed element(s)\n", changes)
+				return buf.String()
+			}
+			rendered++
+			prefix := string(e.kind)
+			buf.WriteString(prefix)
+			buf.WriteString(strings.ReplaceAll(render(e), "\n", "\n"+prefix))
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.String()
+}
+
+// unifiedRange formats a 0-based start and a count like difflib does.
+func unifiedRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
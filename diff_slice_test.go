@@ -0,0 +1,124 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestMyersDiff(t *testing.T) {
+	apply := func(a, b []int, edits []sliceEdit) []int {
+		var out []int
+		for _, e := range edits {
+			switch e.kind {
+			case ' ':
+				New(t).Equal(a[e.a], b[e.b])
+				out = append(out, a[e.a])
+			case '+':
+				out = append(out, b[e.b])
+			}
+		}
+		return out
+	}
+	countEdits := func(edits []sliceEdit) int {
+		n := 0
+		for _, e := range edits {
+			if e.kind != ' ' {
+				n++
+			}
+		}
+		return n
+	}
+
+	edits, ok := myersDiff([]int{1, 2, 3, 1, 2, 2, 1}, []int{3, 2, 1, 2, 1, 3}, 100)
+	New(t).True(ok)
+	New(t).Equal(5, countEdits(edits))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, b := make([]int, r.Intn(20)), make([]int, r.Intn(20))
+		for j := range a {
+			a[j] = r.Intn(4)
+		}
+		for j := range b {
+			b[j] = r.Intn(4)
+		}
+		edits, ok := myersDiff(a, b, 100)
+		New(t).True(ok)
+		New(t).Equal(fmt.Sprint(b), fmt.Sprint(apply(a, b, edits)))
+		New(t).Equal(fmt.Sprint(b), fmt.Sprint(apply(a, b, sliceEditScript(a, b))))
+	}
+
+	_, ok = myersDiff([]int{1, 2, 3}, []int{4, 5, 6}, 5)
+	New(t).False(ok)
+}
+
+func TestDiffLargeSlices(t *testing.T) {
+	expected := make([]int, 50000)
+	actual := make([]int, 50000)
+	for i := range expected {
+		expected[i], actual[i] = i, i
+	}
+	actual[25000] = -1
+	actual = append(actual[:40000], actual[40001:]...)
+
+	New(t).Equal("--- Expected\n+++ Actual\n"+
+		"@@ -25000,3 +25000,3 @@\n (int) 24999,\n-(int) 25000,\n+(int) -1,\n (int) 25001,\n"+
+		"@@ -40000,3 +40000,2 @@\n (int) 39999,\n-(int) 40000,\n (int) 40001,\n",
+		Diff(expected, actual))
+	New(t).Equal("", Diff(expected, expected))
+
+	type point struct{ X, Y int }
+	points := make([]point, 1000)
+	moved := append([]point(nil), points...)
+	moved[0].Y = 1
+	New(t).Equal("--- Expected\n+++ Actual\n@@ -1,2 +1,2 @@\n"+
+		"-(assert.point) {\n- X: (int) 0,\n- Y: (int) 0\n-},\n"+
+		"+(assert.point) {\n+ X: (int) 0,\n+ Y: (int) 1\n+},\n"+
+		" (assert.point) {\n  X: (int) 0,\n  Y: (int) 0\n },\n", Diff(points, moved))
+}
+
+func TestDiffLargeSlicesTruncated(t *testing.T) {
+	expected := make([]int, 5000)
+	actual := make([]int, 5000)
+	for i := range expected {
+		expected[i], actual[i] = i, -i-1
+	}
+
+	d := Diff(expected, actual)
+	New(t).True(strings.HasPrefix(d, "--- Expected\n+++ Actual\n@@ -1,5000 +1,5000 @@\n-(int) 0,\n"))
+	New(t).Equal(maxSliceDiffRendered, strings.Count(d, "\n")-4)
+	New(t).Contains(d, "... diff truncated, 9000 more changed element(s)")
+}
+
+func BenchmarkDiffLargeSlices(b *testing.B) {
+	expected := make([]string, 50000)
+	actual := make([]string, 50000)
+	for i := range expected {
+		expected[i] = fmt.Sprintf("item-%d", i)
+		actual[i] = expected[i]
+	}
+	for i := 0; i < len(actual); i += 5000 {
+		actual[i] = "changed"
+	}
+	for i := 0; i < b.N; i++ {
+		Diff(expected, actual)
+	}
+}
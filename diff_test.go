@@ -0,0 +1,50 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiffOptions(t *testing.T) {
+	expected := []int{1, 2, 3, 4, 5, 6}
+	actual := []int{1, 2, 3, 4, 5, 7}
+
+	New(t).Equal(strings.TrimPrefix(diff(expected, actual), "\n\nDiff:\n"), Diff(expected, actual))
+	New(t).Equal("", Diff(expected, expected))
+	New(t).Equal("", Diff(1, 2))
+	New(t).Equal("", Diff([]int{1}, []string{"1"}))
+
+	New(t).NotContains(Diff(expected, actual), "(int) 4,")
+	New(t).Contains(Diff(expected, actual, DiffContext(3)), "(int) 3,")
+
+	type node struct {
+		Next *node
+		Val  int
+	}
+	deep := &node{Next: &node{Next: &node{Val: 1}}}
+	deeper := &node{Next: &node{Next: &node{Val: 2}}}
+	New(t).Contains(Diff(deep, deeper), "+   Val: (int) 2")
+	New(t).Equal("", Diff(deep, deeper, DiffMaxDepth(2)))
+
+	rendered := Diff(expected, actual, DiffRenderer(func(v any) string {
+		return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(v)), "\n"), "[]")
+	}))
+	New(t).Equal("--- Expected\n+++ Actual\n@@ -5,2 +5,2 @@\n 5\n-6\n+7\n", rendered)
+}
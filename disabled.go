@@ -0,0 +1,23 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build assert_disabled
+
+package assert
+
+// disabled turns every assertion into a no-op that returns true, without
+// evaluating its condition. It is set by building with the assert_disabled
+// tag, for binaries that keep assertions as runtime sanity checks only in
+// development builds.
+const disabled = true
@@ -0,0 +1,45 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build assert_disabled
+
+package assert
+
+import "testing"
+
+// The other tests of this package check failures, so they are built only
+// without the assert_disabled tag.
+func TestDisabled(t *testing.T) {
+	out := &recordingT{}
+	a := New(out)
+
+	if !a.Equal(1, 2) || !a.Nil(42) || !a.Contains("abc", "d") || !Match(a, 1, func(int) bool { return false }, "never") {
+		t.Error("assertions should pass when disabled")
+	}
+	if _, ok := IsTypeOf[string](a, 42); !ok {
+		t.Error("IsTypeOf should pass when disabled")
+	}
+	if _, ok := PanicsWithType[error](a, func() { t.Error("PanicsWithType should not run f when disabled") }); !ok {
+		t.Error("PanicsWithType should pass when disabled")
+	}
+	if !Not((*Assertions).Nil)(a, nil) || !All((*Assertions).Nil)(a, 1) || !Any((*Assertions).Nil)(a, 1) {
+		t.Error("combined assertions should pass when disabled")
+	}
+	if a.Fail("failure") {
+		t.Error("Fail should still return false when disabled")
+	}
+	if failures := out.failures(); len(failures) != 0 {
+		t.Errorf("nothing should be reported when disabled, got: %v", failures)
+	}
+}
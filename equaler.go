@@ -0,0 +1,70 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import "reflect"
+
+// Equaler is implemented by types that define their own equality, such as
+// time.Time, net.IP and decimal types. ObjectsAreEqual, and so Equal and
+// NotEqual, compare two values of such a type T with their Equal method
+// instead of structurally.
+type Equaler[T any] interface {
+	Equal(other T) bool
+}
+
+var boolType = reflect.TypeOf(true)
+
+// equalerEqual compares expected and actual with the Equal method of
+// expected, if they have the same type and it has one of the form
+// Equal(T) bool. It reports whether the method was used.
+func equalerEqual(expected, actual any) (equal, ok bool) {
+	if expected == nil || actual == nil {
+		return false, false
+	}
+	ev := reflect.ValueOf(expected)
+	if ev.Type() != reflect.TypeOf(actual) {
+		return false, false
+	}
+	if ev.Kind() == reflect.Ptr && (ev.IsNil() || reflect.ValueOf(actual).IsNil()) {
+		return false, false
+	}
+	m := ev.MethodByName("Equal")
+	if !m.IsValid() {
+		return false, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.IsVariadic() || mt.In(0) != ev.Type() || mt.NumOut() != 1 || mt.Out(0) != boolType {
+		return false, false
+	}
+	return m.Call([]reflect.Value{reflect.ValueOf(actual)})[0].Bool(), true
+}
+
+// WithStrictEquality returns a new Assertions whose Equal, NotEqual and
+// Exactly compare values structurally even if their type has an Equal
+// method, e.g. to tell apart time.Time values of different locations.
+func (a *Assertions) WithStrictEquality() *Assertions {
+	c := *a
+	c.strictEquality = true
+	return &c
+}
+
+// objectsAreEqual is ObjectsAreEqual, or its structural part under
+// WithStrictEquality.
+func (a *Assertions) objectsAreEqual(expected, actual any) bool {
+	if a.strictEquality {
+		return objectsAreStructurallyEqual(expected, actual)
+	}
+	return ObjectsAreEqual(expected, actual)
+}
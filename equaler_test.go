@@ -0,0 +1,75 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type caseInsensitive string
+
+func (s caseInsensitive) Equal(other caseInsensitive) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+type looseEqual struct{ v int }
+
+// Equal takes a different type than its receiver, so it is not an Equaler.
+func (looseEqual) Equal(any) bool { return true }
+
+func TestObjectsAreEqualUsesEqualer(t *testing.T) {
+	now := time.Now()
+	New(t).True(ObjectsAreEqual(now, now.In(time.UTC)))
+	New(t).True(ObjectsAreEqual(net.ParseIP("10.0.0.1"), net.IPv4(10, 0, 0, 1)))
+	New(t).True(ObjectsAreEqual(caseInsensitive("Go"), caseInsensitive("gO")))
+	New(t).False(ObjectsAreEqual(caseInsensitive("Go"), caseInsensitive("Rust")))
+	New(t).False(ObjectsAreEqual(looseEqual{1}, looseEqual{2}))
+	New(t).False(ObjectsAreEqual(caseInsensitive("Go"), "go"))
+
+	var nilTime *time.Time
+	New(t).True(ObjectsAreEqual(nilTime, nilTime))
+	New(t).False(ObjectsAreEqual(nilTime, &now))
+
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	New(t).True(mockAssertion.Equal(now, now.In(time.UTC)))
+	New(t).False(mockAssertion.NotEqual(now, now.In(time.UTC)))
+	New(t).True(mockAssertion.Exactly(caseInsensitive("a"), caseInsensitive("A")))
+
+	strict := mockAssertion.WithStrictEquality()
+	New(t).False(strict.Equal(now, now.In(time.UTC)))
+	New(t).True(strict.NotEqual(caseInsensitive("a"), caseInsensitive("A")))
+	New(t).False(strict.Exactly(caseInsensitive("a"), caseInsensitive("A")))
+}
+
+func TestEqualTimeInstants(t *testing.T) {
+	now := time.Now()
+	parsed, err := time.Parse(time.RFC3339Nano, now.Format(time.RFC3339Nano))
+	New(t).NoError(err)
+
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+	New(t).True(mockAssertion.Equal(now, parsed))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	later := time.Date(2022, 3, 4, 5, 6, 7, 8, time.UTC)
+	NewWithOnFailureNoop(out).Equal(later, later.Add(time.Second))
+	New(t).Contains(out.buf.String(), "expected: 2022-03-04T05:06:07.000000008Z")
+	New(t).Contains(out.buf.String(), "actual  : 2022-03-04T05:06:08.000000008Z")
+}
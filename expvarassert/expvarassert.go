@@ -0,0 +1,96 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expvarassert provides assertions on the variables published
+// through the expvar package. It is separate from package assert because
+// importing expvar registers the /debug/vars handler on
+// http.DefaultServeMux.
+//
+//	expvarassert.Equals(a, "requests", 10)
+//	expvarassert.Equals(a, "status", map[string]int{"ok": 3, "error": 1})
+package expvarassert
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+
+	"github.com/tisonkun/assert"
+)
+
+type tHelper interface {
+	Helper()
+}
+
+// Published asserts that a variable with the given name is published
+// through the expvar package.
+func Published(a *assert.Assertions, name string, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	if expvar.Get(name) == nil {
+		return a.Fail(fmt.Sprintf("Expvar %q is not published", name), msgAndArgs...)
+	}
+	return true
+}
+
+// Equals asserts that the expvar variable with the given name renders to
+// the same JSON as expected. Maps and other composite values are compared
+// structurally, so key order does not matter.
+func Equals(a *assert.Assertions, name string, expected any, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	v := expvar.Get(name)
+	if v == nil {
+		return a.Fail(fmt.Sprintf("Expvar %q is not published", name), msgAndArgs...)
+	}
+
+	expectedJSON, err := json.Marshal(expected)
+	if err != nil {
+		return a.Fail(fmt.Sprintf("Cannot marshal expected value %#v:\n%+v", expected, err), msgAndArgs...)
+	}
+
+	var expectedValue, actualValue any
+	if err := json.Unmarshal(expectedJSON, &expectedValue); err != nil {
+		return a.Fail(fmt.Sprintf("Cannot unmarshal expected value %#v:\n%+v", expected, err), msgAndArgs...)
+	}
+	actualJSON := v.String()
+	if err := json.Unmarshal([]byte(actualJSON), &actualValue); err != nil {
+		return a.Fail(fmt.Sprintf("Expvar %q ('%s') is not valid json.\nJSON parsing error: '%s'", name, actualJSON, err.Error()), msgAndArgs...)
+	}
+
+	if !assert.ObjectsAreEqual(expectedValue, actualValue) {
+		e, act := indentJSON(expectedValue), indentJSON(actualValue)
+		var diff string
+		if d := assert.Diff(e, act); d != "" {
+			diff = "\n\nDiff:\n" + d
+		}
+		return a.Fail(fmt.Sprintf("Expvar %q not equal: \n"+
+			"expected: %s\n"+
+			"actual  : %s%s", name, e, act, diff), msgAndArgs...)
+	}
+
+	return true
+}
+
+// indentJSON renders a decoded JSON value with one key per line, which keeps
+// diffs of large maps readable.
+func indentJSON(v any) string {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%#v", v)
+	}
+	return string(out)
+}
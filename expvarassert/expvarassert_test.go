@@ -0,0 +1,98 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expvarassert
+
+import (
+	"bytes"
+	"expvar"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/tisonkun/assert"
+)
+
+// outputT records failure messages and the functions marked as helpers.
+type outputT struct {
+	buf     bytes.Buffer
+	helpers map[string]bool
+}
+
+func (t *outputT) Helper() {
+	if t.helpers == nil {
+		t.helpers = map[string]bool{}
+	}
+	pc, _, _, _ := runtime.Caller(1)
+	t.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+func (t *outputT) Errorf(format string, args ...any) {
+	t.buf.WriteString(fmt.Sprintf(format, args...))
+}
+
+func (t *outputT) FailNow() {}
+
+func newRecordingAssertions() (*assert.Assertions, *outputT) {
+	out := new(outputT)
+	return assert.New(out).WithOnFailure(func(assert.TestingT) {}), out
+}
+
+func TestPublished(t *testing.T) {
+	a := assert.New(t)
+	expvar.NewInt("expvarassert_test_published")
+	a.True(Published(a, "expvarassert_test_published"))
+
+	mock, out := newRecordingAssertions()
+	a.False(Published(mock, "expvarassert_test_missing"))
+	a.Contains(out.buf.String(), `Expvar "expvarassert_test_missing" is not published`)
+}
+
+func TestEquals(t *testing.T) {
+	a := assert.New(t)
+	mock, _ := newRecordingAssertions()
+
+	requests := expvar.NewInt("expvarassert_test_requests")
+	requests.Add(10)
+	a.True(Equals(a, "expvarassert_test_requests", 10))
+	a.False(Equals(mock, "expvarassert_test_requests", 11))
+	a.False(Equals(mock, "expvarassert_test_requests", "10"))
+	a.False(Equals(mock, "expvarassert_test_missing", 10))
+
+	name := expvar.NewString("expvarassert_test_name")
+	name.Set("tison")
+	a.True(Equals(a, "expvarassert_test_name", "tison"))
+
+	status := expvar.NewMap("expvarassert_test_status")
+	status.Add("ok", 3)
+	status.Add("error", 1)
+	a.True(Equals(a, "expvarassert_test_status", map[string]int{"error": 1, "ok": 3}))
+	a.False(Equals(mock, "expvarassert_test_status", map[string]int{"ok": 3}))
+	a.False(Equals(mock, "expvarassert_test_status", make(chan int)))
+
+	mock, out := newRecordingAssertions()
+	a.False(Equals(mock, "expvarassert_test_status", map[string]int{"error": 2, "ok": 3}))
+	a.Contains(out.buf.String(), `Expvar "expvarassert_test_status" not equal`)
+	a.Contains(out.buf.String(), `-  "error": 2,`)
+	a.Contains(out.buf.String(), `+  "error": 1,`)
+}
+
+func TestHelpers(t *testing.T) {
+	mock, out := newRecordingAssertions()
+	Published(mock, "expvarassert_test_missing")
+	Equals(mock, "expvarassert_test_missing", 1)
+	for _, name := range []string{"Published", "Equals"} {
+		assert.New(t).True(out.helpers["github.com/tisonkun/assert/expvarassert."+name], name)
+	}
+}
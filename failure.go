@@ -0,0 +1,185 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"strings"
+	"time"
+)
+
+// Failure describes an assertion failure. Fail produces one for every
+// failure it reports, and passes it to the sinks registered with
+// RegisterSink, the hooks added with AddOnFailure and the formatter set
+// with WithFormatter.
+type Failure struct {
+	// Assertion is the name of the assertion that failed, e.g. "Equal".
+	Assertion string
+	// Message is the failure message of the assertion.
+	Message string
+	// UserMessage is the message formatted from msgAndArgs, if any.
+	UserMessage string
+	// Test is the name of the test, if the TestingT has a Name method.
+	Test string
+	// Step is the breadcrumb of the steps the assertion belongs to, e.g.
+	// "checkout > pay"; see Step.
+	Step string
+	// CallerInfo lists the caller frames reported as "Error Trace".
+	CallerInfo []string
+	// Content is the labeled content of the failure message, in the order
+	// it is reported.
+	Content []LabeledContent
+	// Labels are extra labeled contents of the failure, e.g. the case of
+	// RunTable.
+	Labels map[string]string
+	// Expected and Actual are the compared values of assertions such as
+	// Equal; they are nil for other assertions.
+	Expected, Actual any
+	// ExpectedRepr and ActualRepr are Expected and Actual as formatted in
+	// the failure message, and Diff is their difference; they are empty
+	// for assertions that do not compare values.
+	ExpectedRepr, ActualRepr string
+	Diff                     string
+	// Time is when the failure happened.
+	Time time.Time
+}
+
+// LabeledContent is a labeled part of a failure message, such as the
+// "Error" or the "Messages".
+type LabeledContent struct {
+	Label   string
+	Content string
+}
+
+// String formats the failure the way it is reported to the test log by
+// default.
+func (f Failure) String() string {
+	return labeledOutput(toLabeledContent(f.Content)...)
+}
+
+func toLabeledContent(content []LabeledContent) []labeledContent {
+	converted := make([]labeledContent, len(content))
+	for i, c := range content {
+		converted[i] = labeledContent{c.Label, c.Content}
+	}
+	return converted
+}
+
+// failureValues are the compared values of a failed assertion.
+type failureValues struct {
+	expected, actual any
+}
+
+// withValues returns a copy of the Assertions whose failures carry the
+// compared values.
+func (a *Assertions) withValues(expected, actual any) *Assertions {
+	c := *a
+	c.values = &failureValues{expected, actual}
+	return &c
+}
+
+// prefixMessage prepends the prefix set with WithPrefix to the user message.
+func prefixMessage(prefix, msg string) string {
+	switch {
+	case prefix == "":
+		return msg
+	case msg == "":
+		return prefix
+	}
+	return prefix + ": " + msg
+}
+
+// newFailure builds the Failure that describes a failed assertion.
+func (a *Assertions) newFailure(failureMessage string, msgAndArgs ...any) Failure {
+	var callers []string
+	if !a.errorTrace.omit {
+		callers = callerInfo(a.errorTrace)
+	}
+	failureMessage = a.truncateLines(failureMessage)
+	name := a.assertion
+	if name == "" {
+		name = failedAssertion()
+	}
+	failure := Failure{
+		Assertion:   name,
+		Message:     failureMessage,
+		Step:        a.stepBreadcrumb(),
+		UserMessage: prefixMessage(a.prefix, messageFromMsgAndArgs(msgAndArgs...)),
+		CallerInfo:  callers,
+		Time:        time.Now(),
+	}
+
+	var content []labeledContent
+	if !a.errorTrace.omit {
+		content = append(content, labeledContent{"Error Trace", strings.Join(callers, "\n\t\t\t")})
+	}
+	if a.sourceLine {
+		if source, ok := sourceContent(); ok {
+			content = append(content, source)
+		}
+	}
+	content = append(content, labeledContent{"Error", failureMessage})
+	// Add test name if the Go version supports it
+	if n, ok := a.t.(interface {
+		Name() string
+	}); ok {
+		failure.Test = n.Name()
+		content = append(content, labeledContent{"Test", failure.Test})
+	}
+	if failure.Step != "" {
+		content = append(content, labeledContent{"Step", failure.Step})
+	}
+	content = append(content, a.labels...)
+	if failure.UserMessage != "" {
+		content = append(content, labeledContent{"Messages", failure.UserMessage})
+	}
+
+	failure.Content = make([]LabeledContent, len(content))
+	for i, c := range content {
+		failure.Content[i] = LabeledContent{c.label, c.content}
+	}
+	if len(a.labels) > 0 {
+		failure.Labels = make(map[string]string, len(a.labels))
+		for _, l := range a.labels {
+			failure.Labels[l.label] = strings.TrimSpace(l.content)
+		}
+	}
+	if a.values != nil {
+		failure.Expected, failure.Actual = a.values.expected, a.values.actual
+		failure.ExpectedRepr, failure.ActualRepr = formatUnequalValues(a.values.expected, a.values.actual)
+		failure.Diff = a.rawDiff(a.values.expected, a.values.actual)
+	}
+
+	return failure
+}
+
+// WithFormatter returns a new Assertions that reports failures as formatted
+// by format instead of the default layout.
+//
+//	a := assert.New(t).WithFormatter(func(f assert.Failure) string {
+//		return fmt.Sprintf("%s: %s", f.Assertion, f.Message)
+//	})
+func (a *Assertions) WithFormatter(format func(f Failure) string) *Assertions {
+	c := *a
+	c.formatter = format
+	return &c
+}
+
+// render formats a failure for the test log.
+func (a *Assertions) render(failure Failure) string {
+	if a.formatter != nil {
+		return a.formatter(failure)
+	}
+	return a.formatOutput(toLabeledContent(failure.Content)...)
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestFailure(t *testing.T) {
+	var failures []Failure
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).AddOnFailure(func(_ TestingT, f Failure) bool {
+		failures = append(failures, f)
+		return true
+	})
+
+	a.Equal([]int{1}, []int{2}, "payload %d", 7)
+	a.withLabel("Case", "#0").Nil(42)
+	a.ZipEqual([]string{"a"}, []string{"b"})
+
+	New(t).Len(failures, 3)
+	New(t).Equal("Equal", failures[0].Assertion)
+	New(t).Contains(failures[0].Message, "Not equal")
+	New(t).Equal("payload 7", failures[0].UserMessage)
+	New(t).Equal([]int{1}, failures[0].Expected)
+	New(t).Equal([]int{2}, failures[0].Actual)
+	New(t).Equal("[]int{1}", failures[0].ExpectedRepr)
+	New(t).Equal("[]int{2}", failures[0].ActualRepr)
+	New(t).Contains(failures[0].Diff, "+ (int) 2")
+	New(t).False(failures[0].Time.IsZero())
+	New(t).Equal([]string{"Error Trace", "Error", "Messages"}, contentLabels(failures[0]))
+	New(t).Equal(out.buf.String()[1:len(failures[0].String())+1], failures[0].String())
+
+	New(t).Equal("Nil", failures[1].Assertion)
+	New(t).Nil(failures[1].Expected)
+	New(t).Nil(failures[1].Actual)
+	New(t).Empty(failures[1].Diff)
+	New(t).Equal(map[string]string{"Case": "#0"}, failures[1].Labels)
+	New(t).Equal([]string{"Error Trace", "Error", "Case"}, contentLabels(failures[1]))
+
+	New(t).Equal("ZipEqual", failures[2].Assertion)
+	New(t).Equal([]string{"a"}, failures[2].Expected)
+}
+
+func contentLabels(f Failure) []string {
+	labels := make([]string, len(f.Content))
+	for i, c := range f.Content {
+		labels[i] = c.Label
+	}
+	return labels
+}
+
+func TestWithFormatter(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).WithFormatter(func(f Failure) string {
+		return fmt.Sprintf("%s: %v != %v", f.Assertion, f.Expected, f.Actual)
+	})
+
+	New(t).False(a.EqualValues(1, "1"))
+	New(t).Equal("\nEqualValues: 1 != 1", out.buf.String())
+}
+
+func TestWithPrefix(t *testing.T) {
+	var failures []Failure
+	a := NewWithOnFailureNoop(new(testing.T)).AddOnFailure(func(_ TestingT, f Failure) bool {
+		failures = append(failures, f)
+		return true
+	})
+
+	stage := a.WithPrefix("stage=%s", "build")
+	stage.True(false)
+	stage.True(false, "attempt %d", 2)
+	stage.WithPrefix("step=%d", 3).True(false)
+	a.True(false)
+
+	New(t).Len(failures, 4)
+	New(t).Equal("stage=build", failures[0].UserMessage)
+	New(t).Equal("stage=build: attempt 2", failures[1].UserMessage)
+	New(t).Equal("stage=build, step=3", failures[2].UserMessage)
+	New(t).Equal("", failures[3].UserMessage)
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	NewWithOnFailureNoop(out).WithPrefix("stage=%s", "test").Equal(1, 2)
+	New(t).Contains(out.buf.String(), "Messages:   \tstage=test")
+}
+
+func TestWithOnFailureDetail(t *testing.T) {
+	var got []Failure
+	a := New(new(testing.T)).WithOnFailureDetail(func(_ TestingT, f Failure) {
+		got = append(got, f)
+	})
+
+	a.Equal("x", "y", "payload")
+	New(t).Len(got, 1)
+	New(t).Equal(`"x"`, got[0].ExpectedRepr)
+	New(t).Equal(`"y"`, got[0].ActualRepr)
+	New(t).Equal("payload", got[0].UserMessage)
+
+	soft := a.Soft()
+	soft.True(false)
+	soft.AssertAll()
+	New(t).Len(got, 2)
+	New(t).Equal("AssertAll", got[1].Assertion)
+
+	calls := 0
+	a.WithOnFailure(func(TestingT) { calls++ }).True(false)
+	New(t).Len(got, 2)
+	New(t).Equal(1, calls)
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build darwin || dragonfly || freebsd || netbsd || openbsd
+
+package assert
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// openFileDescriptors returns the file descriptors open in the current
+// process. The BSDs do not expose descriptor targets through /dev/fd, so
+// the targets are left empty.
+func openFileDescriptors() (map[int]string, error) {
+	entries, err := os.ReadDir("/dev/fd")
+	if err != nil {
+		return nil, err
+	}
+
+	fds := make(map[int]string, len(entries))
+	for _, entry := range entries {
+		fd, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// The descriptor used to read the directory is closed by now, so
+		// fstat fails on it and it is left out.
+		var stat syscall.Stat_t
+		if err := syscall.Fstat(fd, &stat); err != nil {
+			continue
+		}
+		fds[fd] = ""
+	}
+	return fds, nil
+}
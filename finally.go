@@ -0,0 +1,72 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+// Defer registers assertions to run at the end of the test, such as
+// checking that a connection pool has been drained or that a server shut
+// down cleanly. Like deferred calls, they run in reverse order of
+// registration, after the test function and its deferred calls return.
+//
+// Defer needs a TestingT with a Cleanup method, e.g. *testing.T; with other
+// TestingT values it fails right away.
+//
+//	a.Defer(func(a *assert.Assertions) {
+//		a.Zero(pool.InUse(), "connections leaked")
+//	})
+func (a *Assertions) Defer(f func(a *Assertions)) bool {
+	if disabled {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	c, ok := a.t.(cleaner)
+	if !ok {
+		return a.Fail("Defer needs a TestingT with a Cleanup method")
+	}
+	c.Cleanup(func() {
+		f(a)
+	})
+	return true
+}
+
+// FinallyNoError asserts at the end of the test that f returns no error.
+//
+//	a.FinallyNoError(srv.Shutdown)
+func (a *Assertions) FinallyNoError(f func() error, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Defer(func(a *Assertions) {
+		a.NoError(f(), msgAndArgs...)
+	})
+}
+
+// FinallyNotNil asserts at the end of the test that f returns a value that
+// is not nil.
+func (a *Assertions) FinallyNotNil(f func() any, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.Defer(func(a *Assertions) {
+		a.NotNil(f(), msgAndArgs...)
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestDefer(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a := NewWithOnFailureNoop(out)
+
+	var order []int
+	New(t).True(a.Defer(func(a *Assertions) {
+		order = append(order, 1)
+		a.Equal(0, 2, "connections leaked")
+	}))
+	New(t).True(a.Defer(func(a *Assertions) {
+		order = append(order, 2)
+	}))
+	New(t).Empty(order)
+	New(t).Empty(out.buf.String())
+
+	out.runCleanups()
+	New(t).Equal([]int{2, 1}, order)
+	New(t).Contains(out.buf.String(), "connections leaked")
+
+	out2 := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out2).Defer(func(*Assertions) {}))
+	New(t).Contains(out2.buf.String(), "Defer needs a TestingT with a Cleanup method")
+}
+
+func TestFinally(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a := NewWithOnFailureNoop(out)
+
+	var shutdownErr error
+	var conn any
+	New(t).True(a.FinallyNoError(func() error { return shutdownErr }, "shutdown"))
+	New(t).True(a.FinallyNotNil(func() any { return conn }, "connection"))
+
+	shutdownErr = errors.New("server busy")
+	conn = "conn"
+	out.runCleanups()
+	New(t).Contains(out.buf.String(), "server busy")
+	New(t).NotContains(out.buf.String(), "connection")
+}
@@ -0,0 +1,183 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// subjectPreviewSize caps the runes of a subject shown in failures.
+const subjectPreviewSize = 100
+
+// Subject is a value under test with chainable assertions, see That.
+type Subject struct {
+	a           *Assertions
+	value       any
+	description string
+	passed      bool
+}
+
+// That starts a chain of assertions on value, which reads naturally when a
+// value is checked in several ways. Failures of the chain carry the subject
+// in a "Subject" section, described by As or else by the value itself.
+//
+//	a.That(users).As("active users").IsNotNil().HasLen(3).Contains("tison")
+func (a *Assertions) That(value any) *Subject {
+	return &Subject{a: a, value: value, description: subjectPreview(value), passed: true}
+}
+
+func subjectPreview(value any) string {
+	s := fmt.Sprintf("%#v", value)
+	if utf8.RuneCountInString(s) <= subjectPreviewSize {
+		return s
+	}
+	return string([]rune(s)[:subjectPreviewSize]) + "<... truncated>"
+}
+
+// As describes the subject in failures, instead of its value.
+func (s *Subject) As(description string) *Subject {
+	s.description = description
+	return s
+}
+
+// Passed reports whether every assertion of the chain so far passed.
+func (s *Subject) Passed() bool {
+	return s.passed
+}
+
+func (s *Subject) assertions() *Assertions {
+	return s.a.withLabel("Subject", s.description)
+}
+
+func (s *Subject) check(ok bool) *Subject {
+	s.passed = s.passed && ok
+	return s
+}
+
+// IsEqualTo asserts that the subject equals expected, see Equal.
+func (s *Subject) IsEqualTo(expected any, msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Equal(expected, s.value, msgAndArgs...))
+}
+
+// IsNotEqualTo asserts that the subject does not equal expected, see
+// NotEqual.
+func (s *Subject) IsNotEqualTo(expected any, msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().NotEqual(expected, s.value, msgAndArgs...))
+}
+
+// IsNil asserts that the subject is nil, see Nil.
+func (s *Subject) IsNil(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Nil(s.value, msgAndArgs...))
+}
+
+// IsNotNil asserts that the subject is not nil, see NotNil.
+func (s *Subject) IsNotNil(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().NotNil(s.value, msgAndArgs...))
+}
+
+// IsTrue asserts that the subject is the bool true.
+func (s *Subject) IsTrue(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Equal(true, s.value, msgAndArgs...))
+}
+
+// IsFalse asserts that the subject is the bool false.
+func (s *Subject) IsFalse(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Equal(false, s.value, msgAndArgs...))
+}
+
+// IsZero asserts that the subject is the zero value of its type, see Zero.
+func (s *Subject) IsZero(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Zero(s.value, msgAndArgs...))
+}
+
+// IsNotZero asserts that the subject is not the zero value of its type, see
+// NotZero.
+func (s *Subject) IsNotZero(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().NotZero(s.value, msgAndArgs...))
+}
+
+// IsEmpty asserts that the subject is empty, see Empty.
+func (s *Subject) IsEmpty(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Empty(s.value, msgAndArgs...))
+}
+
+// IsNotEmpty asserts that the subject is not empty, see NotEmpty.
+func (s *Subject) IsNotEmpty(msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().NotEmpty(s.value, msgAndArgs...))
+}
+
+// Contains asserts that the subject contains element, see Contains.
+func (s *Subject) Contains(element any, msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Contains(s.value, element, msgAndArgs...))
+}
+
+// DoesNotContain asserts that the subject does not contain element, see
+// NotContains.
+func (s *Subject) DoesNotContain(element any, msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().NotContains(s.value, element, msgAndArgs...))
+}
+
+// HasLen asserts that the subject has the given length, see Len.
+func (s *Subject) HasLen(length int, msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().Len(s.value, length, msgAndArgs...))
+}
+
+// Satisfies asserts that the subject is matched by matcher, see MatchedBy.
+func (s *Subject) Satisfies(matcher Matcher, msgAndArgs ...any) *Subject {
+	if h, ok := s.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return s.check(s.assertions().MatchedBy(s.value, matcher, msgAndArgs...))
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestThat(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	users := []string{"tison", "alice", "bob"}
+	New(t).True(mockAssertion.That(users).IsNotNil().IsNotEmpty().HasLen(3).Contains("tison").DoesNotContain("eve").Passed())
+	New(t).True(mockAssertion.That(nil).IsNil().IsZero().IsEmpty().Passed())
+	New(t).True(mockAssertion.That(42).IsEqualTo(42).IsNotEqualTo(41).IsNotZero().Passed())
+	New(t).True(mockAssertion.That(true).IsTrue().Passed())
+	New(t).True(mockAssertion.That(false).IsFalse().Passed())
+	New(t).False(mockAssertion.That(1).IsTrue().Passed())
+	New(t).False(mockAssertion.That(users).HasLen(2).Contains("tison").Passed())
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).That(users).As("active users").HasLen(2).Contains("eve").Passed())
+	New(t).Equal(2, strings.Count(out.buf.String(), "Subject:    \tactive users"))
+	New(t).Contains(out.buf.String(), "should have 2 item(s), but has 3")
+	New(t).Contains(out.buf.String(), `does not contain "eve"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).That("tison").IsEqualTo("alice").Passed())
+	New(t).Contains(out.buf.String(), "Subject:    \t\"tison\"")
+	New(t).Contains(out.buf.String(), `expected: "alice"`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	NewWithOnFailureNoop(out).That(strings.Repeat("x", 200)).IsEmpty()
+	New(t).Contains(out.buf.String(), "x<... truncated>")
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// gen.go generates assertion_format.go with an f-suffixed variant of every
+// assertion method of Assertions that takes msgAndArgs, such as Equalf,
+// with the message given as an explicit format string and arguments. Run
+// it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const output = "assertion_format.go"
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != output
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	imports := map[string]string{}
+	used := map[string]bool{}
+	methods := map[string]bool{}
+	var funcs []*ast.FuncDecl
+	for _, f := range pkgs["assert"].Files {
+		for _, spec := range f.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+				methods[fn.Name.Name] = true
+				if isAssertion(fn) {
+					funcs = append(funcs, fn)
+				}
+			}
+		}
+	}
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].Name.Name < funcs[j].Name.Name })
+
+	var body bytes.Buffer
+	for _, fn := range funcs {
+		name := fn.Name.Name
+		if methods[name+"f"] {
+			continue
+		}
+		var params, args []string
+		for _, field := range fn.Type.Params.List[:len(fn.Type.Params.List)-1] {
+			typ := typeString(fset, field.Type, imports, used)
+			var names []string
+			for _, name := range field.Names {
+				names = append(names, name.Name)
+				if _, ok := field.Type.(*ast.Ellipsis); ok {
+					args = append(args, name.Name+"...")
+				} else {
+					args = append(args, name.Name)
+				}
+			}
+			params = append(params, strings.Join(names, ", ")+" "+typ)
+		}
+		params = append(params, "msg string", "args ...any")
+		args = append(args, "append([]any{msg}, args...)...")
+		fmt.Fprintf(&body, `
+// %[1]sf is like %[1]s, with the message given as a format string.
+func (a *Assertions) %[1]sf(%[2]s) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.%[1]s(%[3]s)
+}
+`, name, strings.Join(params, ", "), strings.Join(args, ", "))
+	}
+
+	var out bytes.Buffer
+	header, err := os.ReadFile("assertions.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	out.Write(header[:bytes.Index(header, []byte("package"))])
+	out.WriteString("\n// Code generated by gen.go; DO NOT EDIT.\n\npackage assert\n")
+	if len(used) > 0 {
+		var paths []string
+		for path := range used {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		out.WriteString("\nimport (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+		out.WriteString(")\n")
+	}
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isAssertion reports whether fn is an exported method of *Assertions that
+// returns a single bool and takes msgAndArgs as its last parameter.
+func isAssertion(fn *ast.FuncDecl) bool {
+	if !fn.Name.IsExported() || fn.Type.Results == nil {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	if recv, ok := star.X.(*ast.Ident); !ok || recv.Name != "Assertions" {
+		return false
+	}
+	results := fn.Type.Results.List
+	if len(results) != 1 || len(results[0].Names) > 1 {
+		return false
+	}
+	if result, ok := results[0].Type.(*ast.Ident); !ok || result.Name != "bool" {
+		return false
+	}
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	last := params[len(params)-1]
+	_, variadic := last.Type.(*ast.Ellipsis)
+	return variadic && len(last.Names) == 1 && last.Names[0].Name == "msgAndArgs"
+}
+
+// typeString prints a type expression, recording the imports it uses.
+func typeString(fset *token.FileSet, expr ast.Expr, imports map[string]string, used map[string]bool) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok {
+				used[imports[pkg.Name]] = true
+			}
+			return false
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GitHubAnnotations controls whether failures are emitted as GitHub Actions
+// workflow annotations, which surface inline on the diff of a pull request.
+type GitHubAnnotations int
+
+const (
+	// GitHubAnnotationsOff reports failures as usual.
+	GitHubAnnotationsOff GitHubAnnotations = iota
+	// GitHubAnnotationsAlongside emits an annotation in addition to the
+	// usual failure text.
+	GitHubAnnotationsAlongside
+	// GitHubAnnotationsInstead emits an annotation and reports only the
+	// failure message, without the labeled layout, to the test log.
+	GitHubAnnotationsInstead
+)
+
+// annotationOutput is where annotations are written. Workflow commands must
+// start a line of the job output, which the indented test log never does.
+var annotationOutput io.Writer = os.Stdout
+
+// WithGitHubAnnotations returns a new Assertions that emits failures as
+// "::error file=...,line=...::message" annotations in the given mode when
+// running under GitHub Actions, i.e. when GITHUB_ACTIONS is "true".
+// Elsewhere it reports failures as usual.
+func (a *Assertions) WithGitHubAnnotations(mode GitHubAnnotations) *Assertions {
+	c := *a
+	c.annotations = mode
+	return &c
+}
+
+// annotate emits the failure as a GitHub Actions annotation if configured
+// to, and reports whether the usual failure text should be replaced.
+func (a *Assertions) annotate(failure Failure) (instead bool) {
+	if a.annotations == GitHubAnnotationsOff || os.Getenv("GITHUB_ACTIONS") != "true" {
+		return false
+	}
+
+	title := failure.Assertion
+	if failure.Test != "" {
+		title += " failed in " + failure.Test
+	}
+	message := failure.Message
+	if failure.UserMessage != "" {
+		message += "\n" + failure.UserMessage
+	}
+
+	properties := "title=" + escapeAnnotationProperty(title)
+	if file, line, ok := failureLocation(); ok {
+		properties = fmt.Sprintf("file=%s,line=%d,%s", escapeAnnotationProperty(file), line, properties)
+	}
+	fmt.Fprintf(annotationOutput, "::error %s::%s\n", properties, escapeAnnotationData(message))
+	return a.annotations == GitHubAnnotationsInstead
+}
+
+// failureLocation returns the position of the outermost caller outside of
+// the non-test sources of this package, relative to GITHUB_WORKSPACE.
+func failureLocation() (string, int, bool) {
+	frame, ok := callerFrame()
+	if !ok {
+		return "", 0, false
+	}
+	file := frame.File
+	if workspace := os.Getenv("GITHUB_WORKSPACE"); workspace != "" {
+		if rel, err := filepath.Rel(workspace, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = filepath.ToSlash(rel)
+		}
+	}
+	return file, frame.Line, true
+}
+
+var (
+	annotationDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	annotationPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+func escapeAnnotationData(s string) string {
+	return annotationDataEscaper.Replace(s)
+}
+
+func escapeAnnotationProperty(s string) string {
+	return annotationPropertyEscaper.Replace(s)
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithGitHubAnnotations(t *testing.T) {
+	var annotations bytes.Buffer
+	annotationOutput = &annotations
+	defer func() { annotationOutput = os.Stdout }()
+
+	wd, err := os.Getwd()
+	New(t).NoError(err)
+	t.Setenv("GITHUB_WORKSPACE", filepath.Dir(wd))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).WithGitHubAnnotations(GitHubAnnotationsAlongside).Equal(1, 2))
+	New(t).Equal(0, annotations.Len(), "annotations are only emitted under GitHub Actions")
+
+	t.Setenv("GITHUB_ACTIONS", "true")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).Equal(1, 2))
+	New(t).Equal(0, annotations.Len())
+
+	New(t).False(NewWithOnFailureNoop(out).WithGitHubAnnotations(GitHubAnnotationsAlongside).Equal(1, 2, "a, b: c"))
+	New(t).Regexp(`^::error file=[^/]+/github_test.go,line=\d+,title=Equal::Not equal: %0Aexpected: 1%0Aactual  : 2%0Aa, b: c\n$`, annotations.String())
+	New(t).Contains(out.buf.String(), "Error Trace:")
+
+	annotations.Reset()
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(New(out).WithGitHubAnnotations(GitHubAnnotationsInstead).True(false))
+	New(t).Contains(annotations.String(), "title=True::Should be true\n")
+	New(t).Equal("\nShould be true", out.buf.String())
+}
+
+func TestEscapeAnnotation(t *testing.T) {
+	New(t).Equal("100%25%0Adone: a,b", escapeAnnotationData("100%\ndone: a,b"))
+	New(t).Equal("Equal failed in TestX/a%3Ab%2Cc", escapeAnnotationProperty("Equal failed in TestX/a:b,c"))
+}
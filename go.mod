@@ -4,6 +4,10 @@ go 1.18
 
 require (
 	github.com/davecgh/go-spew v1.1.1
+	github.com/google/go-cmp v0.5.9
 	github.com/pmezard/go-difflib v1.0.0
+	github.com/prometheus/client_model v0.3.0
 	gopkg.in/yaml.v3 v3.0.1
 )
+
+require github.com/golang/protobuf v1.3.5 // indirect
@@ -0,0 +1,62 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GomegaMatcher has the method set of Gomega's types.GomegaMatcher, so that
+// Gomega matchers can be used without this package depending on Gomega.
+type GomegaMatcher interface {
+	Match(actual any) (success bool, err error)
+	FailureMessage(actual any) (message string)
+	NegatedFailureMessage(actual any) (message string)
+}
+
+// FromGomega adapts a Gomega matcher to a Matcher, so that it can be used
+// with MatchedBy, Contains and ElementsMatch.
+//
+//	a.MatchedBy(users, assert.FromGomega(gomega.ContainElement("tison")))
+func FromGomega(m GomegaMatcher) Matcher {
+	return gomegaMatcher{m}
+}
+
+type gomegaMatcher struct {
+	m GomegaMatcher
+}
+
+func (g gomegaMatcher) Matches(actual any) (bool, string) {
+	success, err := g.m.Match(actual)
+	if err != nil {
+		return false, fmt.Sprintf("matcher error: %v", err)
+	}
+	if !success {
+		return false, formatGomegaMessage(g.m.FailureMessage(actual))
+	}
+	return true, ""
+}
+
+// formatGomegaMessage turns a Gomega failure message, which indents values
+// by four spaces under "Expected" and the matcher phrase, into a compact
+// message.
+func formatGomegaMessage(message string) string {
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "    ")
+	}
+	return strings.Join(lines, "\n")
+}
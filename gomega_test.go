@@ -0,0 +1,62 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// equalGomegaMatcher mimics gomega.Equal.
+type equalGomegaMatcher struct {
+	expected any
+}
+
+func (m equalGomegaMatcher) Match(actual any) (bool, error) {
+	if actual == nil && m.expected == nil {
+		return false, errors.New("refusing to compare <nil> to <nil>")
+	}
+	return ObjectsAreEqual(m.expected, actual), nil
+}
+
+func (m equalGomegaMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected\n    <%T>: %v\nto equal\n    <%T>: %v", actual, actual, m.expected, m.expected)
+}
+
+func (m equalGomegaMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected\n    <%T>: %v\nnot to equal\n    <%T>: %v", actual, actual, m.expected, m.expected)
+}
+
+func TestFromGomega(t *testing.T) {
+	mockAssertion := NewWithOnFailureNoop(new(testing.T))
+
+	New(t).True(mockAssertion.MatchedBy(1, FromGomega(equalGomegaMatcher{1})))
+	New(t).False(mockAssertion.MatchedBy(2, FromGomega(equalGomegaMatcher{1})))
+	New(t).True(mockAssertion.Contains([]int{1, 2}, FromGomega(equalGomegaMatcher{2})))
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).MatchedBy(2, FromGomega(equalGomegaMatcher{1})))
+	New(t).Contains(out.buf.String(), "Not matched: Expected\n")
+	New(t).Contains(out.buf.String(), "\t<int>: 2\n")
+	New(t).Contains(out.buf.String(), "\tto equal\n")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	New(t).False(NewWithOnFailureNoop(out).MatchedBy(nil, FromGomega(equalGomegaMatcher{nil})))
+	New(t).Contains(out.buf.String(), "Not matched: matcher error: refusing to compare <nil> to <nil>")
+}
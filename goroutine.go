@@ -0,0 +1,147 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// conditionGoroutine is a goroutine evaluating the condition of an
+// asynchronous assertion such as Eventually or Never.
+type conditionGoroutine struct {
+	id      uint64
+	started chan struct{}
+	done    chan struct{}
+}
+
+// goCondition evaluates condition in a new goroutine and sends the result to
+// ch, which must have room for it.
+func goCondition(condition func() bool, ch chan<- bool) *conditionGoroutine {
+	g := &conditionGoroutine{started: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		defer close(g.done)
+		g.id = goroutineID()
+		close(g.started)
+		ch <- condition()
+	}()
+	return g
+}
+
+// EventuallyContext is like Eventually, but passes the condition a context
+// that is cancelled when the assertion returns, so that a condition still
+// running by then, e.g. blocked on a slow request, can tear itself down
+// instead of outliving the test.
+//
+//	a.EventuallyContext(func(ctx context.Context) bool {
+//		resp, err := client.Health(ctx)
+//		return err == nil && resp.Ready
+//	}, 5*time.Second, 100*time.Millisecond)
+func (a *Assertions) EventuallyContext(condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("EventuallyContext", []any{condition, waitFor, tick, msgAndArgs}, func(a *Assertions) bool {
+			return a.EventuallyContext(condition, waitFor, tick, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	return a.Eventually(func() bool { return condition(ctx) }, waitFor, tick, msgAndArgs...)
+}
+
+// NeverContext is like Never, but passes the condition a context that is
+// cancelled when the assertion returns; see EventuallyContext.
+func (a *Assertions) NeverContext(condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) bool {
+	if disabled {
+		return true
+	}
+	if a.interceptors != nil {
+		return a.intercept("NeverContext", []any{condition, waitFor, tick, msgAndArgs}, func(a *Assertions) bool {
+			return a.NeverContext(condition, waitFor, tick, msgAndArgs...)
+		})
+	}
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	return a.Never(func() bool { return condition(ctx) }, waitFor, tick, msgAndArgs...)
+}
+
+// checkConditionExited registers a check that the condition goroutine g,
+// which was still running when assertion returned, has exited by the end
+// of the test. It is a no-op if g is nil or the TestingT has no Cleanup.
+func (a *Assertions) checkConditionExited(assertion string, g *conditionGoroutine) {
+	if g == nil {
+		return
+	}
+	c, ok := a.t.(cleaner)
+	if !ok {
+		return
+	}
+	c.Cleanup(func() {
+		select {
+		case <-g.done:
+			return
+		case <-g.started:
+		}
+		a.Fail(fmt.Sprintf("Condition of %s is still running at the end of the test:\n%s", assertion, goroutineStack(g.id)))
+	})
+}
+
+// goroutineID parses the id of the current goroutine from its stack trace.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
+
+// goroutineStack returns the stack trace of the goroutine with the given id,
+// or an empty string if it has exited.
+func goroutineStack(id uint64) string {
+	prefix := []byte("goroutine " + strconv.FormatUint(id, 10) + " ")
+	for _, stack := range bytes.Split(allGoroutineStacks(), []byte("\n\n")) {
+		if bytes.HasPrefix(stack, prefix) {
+			return string(stack)
+		}
+	}
+	return ""
+}
+
+// allGoroutineStacks returns the stack traces of all goroutines.
+func allGoroutineStacks() []byte {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+// cleanupT records cleanup functions so tests can run them on demand.
+type cleanupT struct {
+	outputT
+	cleanups []func()
+}
+
+func (t *cleanupT) Cleanup(f func()) {
+	t.cleanups = append(t.cleanups, f)
+}
+
+func (t *cleanupT) runCleanups() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func blockingCondition(release <-chan struct{}) func() bool {
+	return func() bool {
+		<-release
+		return false
+	}
+}
+
+func TestEventuallyLeakedCondition(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	New(t).False(NewWithOnFailureNoop(out).Eventually(blockingCondition(release), 20*time.Millisecond, time.Millisecond))
+	out.buf.Reset()
+	out.runCleanups()
+	New(t).Contains(out.buf.String(), "Condition of Eventually is still running at the end of the test")
+	New(t).Contains(out.buf.String(), "blockingCondition")
+}
+
+func TestNeverLeakedCondition(t *testing.T) {
+	release := make(chan struct{})
+
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	New(t).True(NewWithOnFailureNoop(out).Never(blockingCondition(release), 20*time.Millisecond, time.Millisecond))
+	New(t).Len(out.cleanups, 1)
+
+	close(release)
+	New(t).Eventually(func() bool {
+		out.buf.Reset()
+		out.runCleanups()
+		return out.buf.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestEventuallyNoLeak(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	New(t).True(NewWithOnFailureNoop(out).Eventually(func() bool { return true }, time.Second, time.Millisecond))
+	New(t).Empty(out.cleanups)
+}
+
+func TestEventuallyContextCancelsCondition(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a := NewWithOnFailureNoop(out)
+	New(t).False(a.EventuallyContext(func(ctx context.Context) bool {
+		<-ctx.Done()
+		return false
+	}, 20*time.Millisecond, time.Millisecond))
+	New(t).Contains(out.buf.String(), "Condition never satisfied")
+	New(t).Len(out.cleanups, 1)
+
+	New(t).Eventually(func() bool {
+		out.buf.Reset()
+		out.runCleanups()
+		return out.buf.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	New(t).True(a.EventuallyContext(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}, time.Second, time.Millisecond))
+}
+
+func TestNeverContextCancelsCondition(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	New(t).True(NewWithOnFailureNoop(out).NeverContext(func(ctx context.Context) bool {
+		<-ctx.Done()
+		return true
+	}, 20*time.Millisecond, time.Millisecond))
+
+	New(t).Eventually(func() bool {
+		out.buf.Reset()
+		out.runCleanups()
+		return out.buf.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+}
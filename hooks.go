@@ -0,0 +1,49 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+// FailureHook is called with the Failure when an assertion fails, after the
+// failure is reported. It returns false to skip the hooks after it and the on-failure
+// behaviour, e.g. to keep a test running after a failure it has handled.
+type FailureHook func(t TestingT, failure Failure) bool
+
+// AddOnFailure returns a new Assertions that additionally runs hook on
+// failure. Hooks run in the order they were added, before the behaviour set
+// with WithOnFailure, so that logging, capturing screenshots and failing
+// fast can be composed:
+//
+//	a := assert.New(t).
+//		AddOnFailure(logRequest).
+//		AddOnFailure(saveScreenshot)
+func (a *Assertions) AddOnFailure(hook FailureHook) *Assertions {
+	c := *a
+	c.hooks = append(append([]FailureHook(nil), a.hooks...), hook)
+	return &c
+}
+
+// runOnFailure runs the hooks and then the on-failure behaviour, unless a
+// hook suppresses it.
+func (a *Assertions) runOnFailure(failure Failure) {
+	for _, hook := range a.hooks {
+		if !hook(a.t, failure) {
+			return
+		}
+	}
+	if a.onFailureDetail != nil {
+		a.onFailureDetail(a.t, failure)
+		return
+	}
+	a.onFailure(a.t)
+}
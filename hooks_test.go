@@ -0,0 +1,57 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAddOnFailure(t *testing.T) {
+	var calls []string
+	hook := func(name string, next bool) FailureHook {
+		return func(TestingT, Failure) bool {
+			calls = append(calls, name)
+			return next
+		}
+	}
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	base := New(out).WithOnFailure(func(TestingT) {
+		calls = append(calls, "onFailure")
+	})
+	a := base.AddOnFailure(hook("log", true)).AddOnFailure(hook("screenshot", true))
+
+	New(t).True(a.Equal(1, 1))
+	New(t).Empty(calls)
+
+	New(t).False(a.Equal(1, 2))
+	New(t).Equal([]string{"log", "screenshot", "onFailure"}, calls)
+	New(t).Contains(out.buf.String(), "Not equal")
+
+	calls = nil
+	New(t).False(a.AddOnFailure(hook("suppress", false)).AddOnFailure(hook("never", true)).Fail("failure"))
+	New(t).Equal([]string{"log", "screenshot", "suppress"}, calls)
+
+	calls = nil
+	New(t).False(base.Fail("failure"))
+	New(t).Equal([]string{"onFailure"}, calls, "hooks do not leak into the Assertions they were added to")
+
+	calls = nil
+	New(t).False(a.Quiet().Fail("failure"))
+	New(t).Empty(calls)
+}
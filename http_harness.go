@@ -0,0 +1,197 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// cleaner is implemented by *testing.T and *testing.B.
+type cleaner interface {
+	Cleanup(func())
+}
+
+// HTTPHarness serves a handler with an httptest.Server and issues requests
+// against it whose responses are checked with chained assertions.
+//
+//	h := a.HTTPHarness(handler)
+//	h.GET("/users/1").ExpectStatus(http.StatusOK).ExpectJSON(`{"id": 1}`)
+type HTTPHarness struct {
+	a *Assertions
+	// Server is the test server serving the handler.
+	Server *httptest.Server
+	// Client is the client used to issue requests to Server.
+	Client *http.Client
+}
+
+// HTTPHarness starts an httptest.Server for handler. The server is closed
+// when the test completes if the TestingT supports Cleanup, and by calling
+// Close otherwise.
+func (a *Assertions) HTTPHarness(handler http.Handler) *HTTPHarness {
+	server := httptest.NewServer(handler)
+	h := &HTTPHarness{a: a, Server: server, Client: server.Client()}
+	if c, ok := a.t.(cleaner); ok {
+		c.Cleanup(h.Close)
+	}
+	return h
+}
+
+// Close shuts down the server.
+func (h *HTTPHarness) Close() {
+	h.Server.Close()
+}
+
+// GET issues a GET request for path.
+func (h *HTTPHarness) GET(path string) *HTTPResponse {
+	if th, ok := h.a.t.(tHelper); ok {
+		th.Helper()
+	}
+	return h.Do(http.MethodGet, path, "", nil)
+}
+
+// POST issues a POST request for path with the given body.
+func (h *HTTPHarness) POST(path, contentType string, body io.Reader) *HTTPResponse {
+	if th, ok := h.a.t.(tHelper); ok {
+		th.Helper()
+	}
+	return h.Do(http.MethodPost, path, contentType, body)
+}
+
+// PUT issues a PUT request for path with the given body.
+func (h *HTTPHarness) PUT(path, contentType string, body io.Reader) *HTTPResponse {
+	if th, ok := h.a.t.(tHelper); ok {
+		th.Helper()
+	}
+	return h.Do(http.MethodPut, path, contentType, body)
+}
+
+// DELETE issues a DELETE request for path.
+func (h *HTTPHarness) DELETE(path string) *HTTPResponse {
+	if th, ok := h.a.t.(tHelper); ok {
+		th.Helper()
+	}
+	return h.Do(http.MethodDelete, path, "", nil)
+}
+
+// Do issues a request with the given method for path. The response body is
+// read completely, so the returned response can be checked any number of
+// times. A failed request is reported as a failure, after which all the
+// expectations on the response are skipped.
+func (h *HTTPHarness) Do(method, path, contentType string, body io.Reader) *HTTPResponse {
+	if th, ok := h.a.t.(tHelper); ok {
+		th.Helper()
+	}
+
+	r := &HTTPResponse{a: h.a, method: method, path: path}
+
+	req, err := http.NewRequest(method, h.Server.URL+path, body)
+	if err != nil {
+		h.a.Fail(fmt.Sprintf("Cannot create request %s %s: %s", method, path, err))
+		return r
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := h.Client.Do(req)
+	if err != nil {
+		h.a.Fail(fmt.Sprintf("Request %s %s failed: %s", method, path, err))
+		return r
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		h.a.Fail(fmt.Sprintf("Cannot read response body of %s %s: %s", method, path, err))
+		return r
+	}
+
+	r.Response, r.Body = resp, b
+	return r
+}
+
+// HTTPResponse is the response to a request issued by an HTTPHarness.
+type HTTPResponse struct {
+	a      *Assertions
+	method string
+	path   string
+
+	// Response is the received response, whose body has been consumed
+	// into Body. It is nil if the request failed.
+	Response *http.Response
+	// Body is the content of the response body.
+	Body []byte
+}
+
+// ExpectStatus asserts that the response has the given status code.
+func (r *HTTPResponse) ExpectStatus(code int, msgAndArgs ...any) *HTTPResponse {
+	if h, ok := r.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Response != nil && r.Response.StatusCode != code {
+		r.a.Fail(fmt.Sprintf("Expected status code %d for %s %s, but got %d\n\nBody:\n%s", code, r.method, r.path, r.Response.StatusCode, r.Body), msgAndArgs...)
+	}
+	return r
+}
+
+// ExpectHeader asserts that the response header has the given value.
+func (r *HTTPResponse) ExpectHeader(key, value string, msgAndArgs ...any) *HTTPResponse {
+	if h, ok := r.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Response != nil {
+		r.a.Equal(value, r.Response.Header.Get(key), msgAndArgs...)
+	}
+	return r
+}
+
+// ExpectBody asserts that the response body equals the expected string.
+func (r *HTTPResponse) ExpectBody(expected string, msgAndArgs ...any) *HTTPResponse {
+	if h, ok := r.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Response != nil {
+		r.a.Equal(expected, string(r.Body), msgAndArgs...)
+	}
+	return r
+}
+
+// ExpectBodyContains asserts that the response body contains the given
+// string.
+func (r *HTTPResponse) ExpectBodyContains(str string, msgAndArgs ...any) *HTTPResponse {
+	if h, ok := r.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Response != nil && !strings.Contains(string(r.Body), str) {
+		r.a.Fail(fmt.Sprintf("Expected response body of %s %s to contain %q\n\nBody:\n%s", r.method, r.path, str, r.Body), msgAndArgs...)
+	}
+	return r
+}
+
+// ExpectJSON asserts that the response body is a JSON document equivalent
+// to the expected one.
+func (r *HTTPResponse) ExpectJSON(expected string, msgAndArgs ...any) *HTTPResponse {
+	if h, ok := r.a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Response != nil {
+		r.a.JSONEq(expected, r.Body, msgAndArgs...)
+	}
+	return r
+}
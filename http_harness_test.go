@@ -0,0 +1,80 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func harnessHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"id": 1, "name": "tison"}`)
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, _ = io.Copy(w, r.Body)
+	})
+	return mux
+}
+
+func TestHTTPHarness(t *testing.T) {
+	h := New(t).HTTPHarness(harnessHandler())
+
+	h.GET("/json").
+		ExpectStatus(http.StatusOK).
+		ExpectHeader("Content-Type", "application/json").
+		ExpectJSON(`{"name": "tison", "id": 1}`).
+		ExpectBodyContains("tison")
+
+	h.POST("/echo", "text/plain", strings.NewReader("hello")).
+		ExpectStatus(http.StatusOK).
+		ExpectHeader("Content-Type", "text/plain").
+		ExpectBody("hello")
+
+	h.GET("/missing").ExpectStatus(http.StatusNotFound)
+}
+
+func TestHTTPHarnessFailures(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	h := NewWithOnFailureNoop(out).HTTPHarness(harnessHandler())
+	defer h.Close()
+
+	h.GET("/json").ExpectStatus(http.StatusCreated)
+	New(t).Contains(out.buf.String(), "Expected status code 201 for GET /json, but got 200")
+
+	out.buf.Reset()
+	h.DELETE("/json").ExpectJSON(`{"id": 2}`)
+	New(t).Contains(out.buf.String(), "Not equal")
+
+	out.buf.Reset()
+	h.PUT("/echo", "text/plain", strings.NewReader("hello")).ExpectBodyContains("bye")
+	New(t).Contains(out.buf.String(), `Expected response body of PUT /echo to contain "bye"`)
+
+	out.buf.Reset()
+	h.Close()
+	r := h.GET("/json").ExpectStatus(http.StatusOK).ExpectBody("")
+	New(t).Nil(r.Response)
+	New(t).Contains(out.buf.String(), "Request GET /json failed")
+	New(t).NotContains(out.buf.String(), "Expected status code")
+}
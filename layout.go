@@ -0,0 +1,102 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// WithLineWidth returns a new Assertions that soft wraps the contents of
+// failure messages at width characters, breaking at spaces where possible.
+// The label column and the Error Trace are not wrapped. A width of zero or
+// less disables wrapping, which is the default.
+func (a *Assertions) WithLineWidth(width int) *Assertions {
+	c := *a
+	c.lineWidth = width
+	return &c
+}
+
+// WithPlainLayout returns a new Assertions that renders failure messages as
+// "Label: content" lines without tab alignment, which survives log systems
+// that collapse whitespace. Continuation lines are indented by two spaces.
+func (a *Assertions) WithPlainLayout() *Assertions {
+	c := *a
+	c.plainLayout = true
+	return &c
+}
+
+// formatOutput renders the labeled contents of a failure according to the
+// layout settings of the Assertions.
+func (a *Assertions) formatOutput(content ...labeledContent) string {
+	if a.lineWidth > 0 {
+		wrapped := make([]labeledContent, len(content))
+		for i, v := range content {
+			wrapped[i] = v
+			if v.label != "Error Trace" {
+				wrapped[i].content = wrapLines(v.content, a.lineWidth)
+			}
+		}
+		content = wrapped
+	}
+	if a.plainLayout {
+		return plainLabeledOutput(content...)
+	}
+	return labeledOutput(content...)
+}
+
+// plainLabeledOutput is the counterpart of labeledOutput without alignment:
+//
+//	{{label}}: {{content}}\n
+func plainLabeledOutput(content ...labeledContent) string {
+	var output strings.Builder
+	for _, v := range content {
+		output.WriteString(v.label + ": " + strings.ReplaceAll(strings.TrimRight(v.content, "\n"), "\n", "\n  ") + "\n")
+	}
+	return output.String()
+}
+
+// wrapLines breaks every line of s that is longer than width characters,
+// preferably at the last space that fits.
+func wrapLines(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	wrapped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		for utf8.RuneCountInString(line) > width {
+			cut := runeOffset(line, width)
+			// A space right after the cut is a fine break point as well.
+			if i := strings.LastIndexByte(line[:cut+1], ' '); i > 0 {
+				wrapped = append(wrapped, line[:i])
+				line = line[i+1:]
+			} else {
+				wrapped = append(wrapped, line[:cut])
+				line = line[cut:]
+			}
+		}
+		wrapped = append(wrapped, line)
+	}
+	return strings.Join(wrapped, "\n")
+}
+
+// runeOffset returns the byte offset of the n-th rune of s.
+func runeOffset(s string, n int) int {
+	for i := range s {
+		if n == 0 {
+			return i
+		}
+		n--
+	}
+	return len(s)
+}
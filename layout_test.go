@@ -0,0 +1,49 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWithLineWidth(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	NewWithOnFailureNoop(out).WithLineWidth(20).Fail("the quick brown fox jumps over the lazy dog", "abcdefghijklmnopqrstuvwxyz")
+	New(t).Contains(out.buf.String(), "the quick brown fox\n")
+	New(t).Contains(out.buf.String(), "jumps over the lazy\n")
+	New(t).Contains(out.buf.String(), "abcdefghijklmnopqrst\n")
+	New(t).Contains(out.buf.String(), "\tuvwxyz\n")
+
+	New(t).Equal("héllo\nwörld", wrapLines("héllo wörld", 5))
+	New(t).Equal("abc\ndef\ng", wrapLines("abcdefg", 3))
+	New(t).Equal("short\n\nlines", wrapLines("short\n\nlines", 10))
+}
+
+func TestWithPlainLayout(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	NewWithOnFailureNoop(out).WithPlainLayout().Fail("first\nsecond", "message")
+	New(t).Contains(out.buf.String(), "\nError: first\n  second\n")
+	New(t).Contains(out.buf.String(), "\nMessages: message\n")
+	New(t).False(strings.Contains(out.buf.String(), "\t"))
+
+	New(t).Equal("A: x\nLonger: y\n  z\n", plainLabeledOutput(
+		labeledContent{"A", "x"},
+		labeledContent{"Longer", "y\nz"},
+	))
+}
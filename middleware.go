@@ -0,0 +1,79 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+// Call is an invocation of an assertion, as seen by interceptors.
+type Call struct {
+	// Name is the name of the assertion, e.g. "Equal".
+	Name string
+	// Args are the arguments of the assertion; variadic arguments such as
+	// msgAndArgs are passed as a single slice. They are read-only: the
+	// assertion always runs with the arguments it was called with, so
+	// changing Args does not change what is asserted.
+	Args []any
+	// Assertions is what the assertion runs on. Interceptors can replace
+	// it, e.g. with Quiet() to retry a flaky assertion without reporting
+	// the failed attempts.
+	Assertions *Assertions
+}
+
+// AssertionCall runs an assertion call and returns its verdict.
+type AssertionCall func(c Call) bool
+
+// Use returns a new Assertions whose assertions run through interceptor,
+// which wraps every assertion call. Interceptors added first are outermost.
+// They enable cross-cutting behaviour such as timing, logging of passing
+// assertions or retries:
+//
+//	a = a.Use(func(next assert.AssertionCall) assert.AssertionCall {
+//		return func(c assert.Call) bool {
+//			start := time.Now()
+//			defer func() { log.Printf("%s took %v", c.Name, time.Since(start)) }()
+//			return next(c)
+//		}
+//	})
+//
+// Generic assertions such as EqualT are intercepted too. Assertions that are
+// implemented in terms of other assertions are intercepted only once, at the
+// outermost call.
+func (a *Assertions) Use(interceptor func(next AssertionCall) AssertionCall) *Assertions {
+	c := *a
+	c.interceptors = append(append([]func(AssertionCall) AssertionCall(nil), a.interceptors...), interceptor)
+	return &c
+}
+
+// intercept runs the assertion named name through the interceptors. The
+// assertion itself runs on a copy of the Assertions without interceptors,
+// so that the assertions it is built on are not intercepted again.
+func (a *Assertions) intercept(name string, args []any, run func(a *Assertions) bool) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	call := AssertionCall(func(c Call) bool {
+		if h, ok := c.Assertions.t.(tHelper); ok {
+			h.Helper()
+		}
+		return run(c.Assertions)
+	})
+	for i := len(a.interceptors) - 1; i >= 0; i-- {
+		call = a.interceptors[i](call)
+	}
+
+	inner := *a
+	inner.interceptors = nil
+	inner.assertion = name
+	return call(Call{Name: name, Args: args, Assertions: &inner})
+}
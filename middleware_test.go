@@ -0,0 +1,136 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestUse(t *testing.T) {
+	var log []string
+	logging := func(prefix string) func(AssertionCall) AssertionCall {
+		return func(next AssertionCall) AssertionCall {
+			return func(c Call) bool {
+				log = append(log, fmt.Sprintf("%s>%s%v", prefix, c.Name, c.Args))
+				ok := next(c)
+				log = append(log, fmt.Sprintf("%s<%v", prefix, ok))
+				return ok
+			}
+		}
+	}
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).Use(logging("outer")).Use(logging("inner"))
+
+	New(t).True(a.Exactly(1, 1, "msg"))
+	New(t).Equal([]string{"outer>Exactly[1 1 [msg]]", "inner>Exactly[1 1 [msg]]", "inner<true", "outer<true"}, log)
+
+	log = nil
+	New(t).False(a.Contains("abc", "d"))
+	New(t).Equal([]string{"outer>Contains[abc d []]", "inner>Contains[abc d []]", "inner<false", "outer<false"}, log)
+	New(t).Contains(out.buf.String(), "does not contain")
+
+	log = nil
+	New(t).True(NewWithOnFailureNoop(out).Equal(1, 1))
+	New(t).Empty(log, "interceptors do not leak into the Assertions they were added to")
+}
+
+func TestUseRetry(t *testing.T) {
+	retry := func(next AssertionCall) AssertionCall {
+		return func(c Call) bool {
+			loud := c.Assertions
+			c.Assertions = loud.Quiet()
+			for i := 0; i < 2; i++ {
+				if next(c) {
+					return true
+				}
+			}
+			c.Assertions = loud
+			return next(c)
+		}
+	}
+
+	attempts := 0
+	flaky := func() bool {
+		attempts++
+		return attempts >= 2
+	}
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).Use(retry)
+	New(t).True(a.Condition(flaky))
+	New(t).Equal(2, attempts)
+	New(t).Empty(out.buf.String())
+
+	New(t).False(a.Condition(func() bool { return false }))
+	New(t).Contains(out.buf.String(), "Condition failed!")
+}
+
+func TestUseSkip(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).Use(func(next AssertionCall) AssertionCall {
+		return func(c Call) bool {
+			if c.Name == "Nil" {
+				return true
+			}
+			return next(c)
+		}
+	})
+	New(t).True(a.Nil(42))
+	New(t).False(a.NotNil(nil))
+	New(t).NotContains(out.buf.String(), "Expected nil")
+	New(t).Contains(out.buf.String(), "Expected value not to be nil.")
+}
+
+func TestUseFailureAssertionName(t *testing.T) {
+	passthrough := func(next AssertionCall) AssertionCall {
+		return func(c Call) bool { return next(c) }
+	}
+	var names []string
+	a := NewWithOnFailureNoop(new(testing.T)).AddOnFailure(func(_ TestingT, f Failure) bool {
+		names = append(names, f.Assertion)
+		return true
+	}).Use(passthrough)
+
+	a.Equal(1, 2)
+	a.Exactly(1, int64(1))
+	EqualT(a, 1, 2)
+	New(t).Equal([]string{"Equal", "Exactly", "EqualT"}, names)
+}
+
+func TestUseGenericAndDeepContains(t *testing.T) {
+	var names []string
+	a := NewWithOnFailureNoop(new(testing.T)).Use(func(next AssertionCall) AssertionCall {
+		return func(c Call) bool {
+			names = append(names, c.Name)
+			return next(c)
+		}
+	})
+
+	paths, ok := a.DeepContains(map[string][]int{"k": {7}}, 7)
+	New(t).True(ok)
+	New(t).Equal([]string{`$["k"][0]`}, paths)
+	New(t).True(EqualT(a, 1, 1))
+	New(t).False(GreaterT(a, 1, 2))
+	New(t).True(Match(a, 2, func(v int) bool { return v > 1 }, "is greater than 1"))
+	v, ok := IsTypeOf[int](a, 42)
+	New(t).True(ok)
+	New(t).Equal(42, v)
+	New(t).Equal(42, MustNoError(a, 42, nil))
+	New(t).Equal([]string{"DeepContains", "EqualT", "GreaterT", "Match", "IsTypeOf", "MustNoError"}, names)
+}
@@ -0,0 +1,375 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock provides a Mock type to embed in hand written fakes, which
+// records calls against expectations declared with On and verifies them
+// through assert.Assertions.
+//
+//	type FakeStore struct {
+//		mock.Mock
+//	}
+//
+//	func (s *FakeStore) Get(key string) (string, error) {
+//		args := s.Called(key)
+//		return args.String(0), args.Error(1)
+//	}
+//
+//	store := new(FakeStore)
+//	store.On("Get", "foo").Return("bar", nil).Once()
+//	...
+//	store.AssertExpectations(assert.New(t))
+package mock
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/tisonkun/assert"
+)
+
+type tHelper interface {
+	Helper()
+}
+
+// Anything is used in expectations to match any argument.
+const Anything = "mock.Anything"
+
+// Arguments holds the arguments or the return values of a call.
+type Arguments []any
+
+// Get returns the argument at the specified index.
+func (args Arguments) Get(index int) any {
+	if index >= len(args) {
+		panic(fmt.Sprintf("mock: cannot get argument %d because there are only %d argument(s)", index, len(args)))
+	}
+	return args[index]
+}
+
+// String returns the argument at the specified index as a string.
+func (args Arguments) String(index int) string {
+	s, ok := args.Get(index).(string)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not string", index, args.Get(index)))
+	}
+	return s
+}
+
+// Int returns the argument at the specified index as an int.
+func (args Arguments) Int(index int) int {
+	i, ok := args.Get(index).(int)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not int", index, args.Get(index)))
+	}
+	return i
+}
+
+// Bool returns the argument at the specified index as a bool.
+func (args Arguments) Bool(index int) bool {
+	b, ok := args.Get(index).(bool)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not bool", index, args.Get(index)))
+	}
+	return b
+}
+
+// Error returns the argument at the specified index as an error, which may
+// be nil.
+func (args Arguments) Error(index int) error {
+	v := args.Get(index)
+	if v == nil {
+		return nil
+	}
+	err, ok := v.(error)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not error", index, v))
+	}
+	return err
+}
+
+// matches reports whether the actual arguments satisfy the expected ones.
+func (args Arguments) matches(actual Arguments) bool {
+	if len(args) != len(actual) {
+		return false
+	}
+	for i, expected := range args {
+		if expected == Anything {
+			continue
+		}
+		if !assert.ObjectsAreEqual(expected, actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Call represents an expected method call declared with Mock.On.
+type Call struct {
+	parent *Mock
+
+	// Method is the name of the expected method.
+	Method string
+	// Arguments are the expected arguments.
+	Arguments Arguments
+	// ReturnArguments are the values returned by the call.
+	ReturnArguments Arguments
+
+	// repeatability is the number of times the call may be made, where 0
+	// means any number of times.
+	repeatability int
+	totalCalls    int
+	optional      bool
+	run           func(args Arguments)
+}
+
+// Return specifies the values returned by the call.
+func (c *Call) Return(returnArguments ...any) *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+	c.ReturnArguments = returnArguments
+	return c
+}
+
+// Once indicates that the call is expected exactly once.
+func (c *Call) Once() *Call {
+	return c.Times(1)
+}
+
+// Twice indicates that the call is expected exactly twice.
+func (c *Call) Twice() *Call {
+	return c.Times(2)
+}
+
+// Times indicates that the call is expected exactly n times.
+func (c *Call) Times(n int) *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+	c.repeatability = n
+	return c
+}
+
+// Maybe marks the call as optional, so that AssertExpectations does not fail
+// when it is never made.
+func (c *Call) Maybe() *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+	c.optional = true
+	return c
+}
+
+// Run sets a handler invoked with the arguments of each matching call,
+// before the return values are returned.
+func (c *Call) Run(fn func(args Arguments)) *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+	c.run = fn
+	return c
+}
+
+// On chains a new expectation on the same mock.
+func (c *Call) On(methodName string, arguments ...any) *Call {
+	return c.parent.On(methodName, arguments...)
+}
+
+func (c *Call) String() string {
+	return fmt.Sprintf("%s(%s)", c.Method, formatArguments(c.Arguments))
+}
+
+// recordedCall is a call actually made on the mock.
+type recordedCall struct {
+	method    string
+	arguments Arguments
+}
+
+// Mock is the workhorse used to track activity on another object. Embed it
+// in a fake and forward methods to Called.
+type Mock struct {
+	mu            sync.Mutex
+	expectedCalls []*Call
+	calls         []recordedCall
+}
+
+// On declares an expected call of the named method with the given
+// arguments, which may be Anything.
+func (m *Mock) On(methodName string, arguments ...any) *Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c := &Call{parent: m, Method: methodName, Arguments: arguments}
+	m.expectedCalls = append(m.expectedCalls, c)
+	return c
+}
+
+// Called records a call of the calling method with the given arguments and
+// returns the values declared for it. It panics when the call was not
+// expected.
+func (m *Mock) Called(arguments ...any) Arguments {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		panic("mock: cannot get the caller information")
+	}
+	return m.MethodCalled(methodName(runtime.FuncForPC(pc).Name()), arguments...)
+}
+
+// MethodCalled records a call of the named method with the given arguments
+// and returns the values declared for it. It panics when the call was not
+// expected.
+func (m *Mock) MethodCalled(methodName string, arguments ...any) Arguments {
+	m.mu.Lock()
+	var call *Call
+	for _, c := range m.expectedCalls {
+		if c.Method != methodName || !c.Arguments.matches(arguments) {
+			continue
+		}
+		if c.repeatability > 0 && c.totalCalls >= c.repeatability {
+			continue
+		}
+		call = c
+		break
+	}
+	if call == nil {
+		expected := m.expectedCallsString()
+		m.mu.Unlock()
+		panic(fmt.Sprintf("mock: unexpected call %s(%s)\n\nExpected calls:\n%s", methodName, formatArguments(arguments), expected))
+	}
+	call.totalCalls++
+	m.calls = append(m.calls, recordedCall{method: methodName, arguments: arguments})
+	run, returnArguments := call.run, call.ReturnArguments
+	m.mu.Unlock()
+
+	if run != nil {
+		run(arguments)
+	}
+	return returnArguments
+}
+
+// AssertExpectations asserts that every call declared with On, except the
+// ones marked with Maybe, was made the expected number of times.
+func (m *Mock) AssertExpectations(a *assert.Assertions, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	m.mu.Lock()
+	var unmet []string
+	for _, c := range m.expectedCalls {
+		switch {
+		case c.repeatability > 0 && c.totalCalls != c.repeatability:
+			unmet = append(unmet, fmt.Sprintf("%s: expected %d call(s), got %d", c, c.repeatability, c.totalCalls))
+		case c.repeatability == 0 && c.totalCalls == 0 && !c.optional:
+			unmet = append(unmet, fmt.Sprintf("%s: never called", c))
+		}
+	}
+	m.mu.Unlock()
+
+	if len(unmet) > 0 {
+		return a.Fail(fmt.Sprintf("%d expectation(s) not met:\n%s", len(unmet), strings.Join(unmet, "\n")), msgAndArgs...)
+	}
+	return true
+}
+
+// AssertCalled asserts that the named method was called with the given
+// arguments, which may be Anything.
+func (m *Mock) AssertCalled(a *assert.Assertions, methodName string, arguments ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	if m.wasCalled(methodName, arguments) {
+		return true
+	}
+	return a.Fail(fmt.Sprintf("Should have called %s(%s)\n\nActual calls:\n%s", methodName, formatArguments(arguments), m.callsString()))
+}
+
+// AssertNotCalled asserts that the named method was not called with the
+// given arguments, which may be Anything.
+func (m *Mock) AssertNotCalled(a *assert.Assertions, methodName string, arguments ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	if !m.wasCalled(methodName, arguments) {
+		return true
+	}
+	return a.Fail(fmt.Sprintf("Should not have called %s(%s)\n\nActual calls:\n%s", methodName, formatArguments(arguments), m.callsString()))
+}
+
+// AssertNumberOfCalls asserts that the named method was called the
+// expected number of times, whatever the arguments.
+func (m *Mock) AssertNumberOfCalls(a *assert.Assertions, methodName string, expectedCalls int, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	m.mu.Lock()
+	actualCalls := 0
+	for _, c := range m.calls {
+		if c.method == methodName {
+			actualCalls++
+		}
+	}
+	m.mu.Unlock()
+
+	if actualCalls != expectedCalls {
+		return a.Fail(fmt.Sprintf("Expected number of calls (%d) of %s does not match the actual number of calls (%d)", expectedCalls, methodName, actualCalls), msgAndArgs...)
+	}
+	return true
+}
+
+func (m *Mock) wasCalled(methodName string, arguments Arguments) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.calls {
+		if c.method == methodName && arguments.matches(c.arguments) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Mock) callsString() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.calls) == 0 {
+		return "(none)"
+	}
+	lines := make([]string, 0, len(m.calls))
+	for _, c := range m.calls {
+		lines = append(lines, fmt.Sprintf("%s(%s)", c.method, formatArguments(c.arguments)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// expectedCallsString must be called with m.mu held.
+func (m *Mock) expectedCallsString() string {
+	if len(m.expectedCalls) == 0 {
+		return "(none)"
+	}
+	lines := make([]string, 0, len(m.expectedCalls))
+	for _, c := range m.expectedCalls {
+		lines = append(lines, c.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatArguments(args Arguments) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		parts = append(parts, fmt.Sprintf("%#v", arg))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// methodName extracts the method name from the fully qualified name of a
+// function, e.g. "pkg.(*FakeStore).Get-fm" gives "Get".
+func methodName(functionPath string) string {
+	parts := strings.Split(functionPath, ".")
+	return strings.TrimSuffix(parts[len(parts)-1], "-fm")
+}
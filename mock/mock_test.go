@@ -0,0 +1,200 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/tisonkun/assert"
+)
+
+type fakeStore struct {
+	Mock
+}
+
+func (s *fakeStore) Get(key string) (string, error) {
+	args := s.Called(key)
+	return args.String(0), args.Error(1)
+}
+
+func (s *fakeStore) Put(key, value string) error {
+	return s.Called(key, value).Error(0)
+}
+
+// outputT records failure messages and the functions marked as helpers.
+type outputT struct {
+	buf     bytes.Buffer
+	helpers map[string]bool
+}
+
+func (t *outputT) Helper() {
+	if t.helpers == nil {
+		t.helpers = map[string]bool{}
+	}
+	pc, _, _, _ := runtime.Caller(1)
+	t.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+// marked reports whether the function with the given name, relative to
+// the package, was marked as a helper.
+func (t *outputT) marked(name string) bool {
+	return t.helpers["github.com/tisonkun/assert/mock."+name]
+}
+
+func (t *outputT) Errorf(format string, args ...any) {
+	t.buf.WriteString(fmt.Sprintf(format, args...))
+}
+
+func (t *outputT) FailNow() {}
+
+func newRecordingAssertions() (*assert.Assertions, *outputT) {
+	out := new(outputT)
+	return assert.New(out).WithOnFailure(func(assert.TestingT) {}), out
+}
+
+func TestCalled(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Get", "foo").Return("bar", nil).Once()
+	s.On("Get", Anything).Return("", errors.New("not found"))
+	s.On("Put", "foo", "baz").Return(nil)
+
+	v, err := s.Get("foo")
+	assert.New(t).Equal("bar", v)
+	assert.New(t).NoError(err)
+
+	_, err = s.Get("foo")
+	assert.New(t).EqualError(err, "not found")
+
+	assert.New(t).NoError(s.Put("foo", "baz"))
+	assert.New(t).Panics(func() { _ = s.Put("foo", "qux") })
+}
+
+func TestRun(t *testing.T) {
+	s := new(fakeStore)
+	var seen Arguments
+	s.On("Put", Anything, Anything).Return(nil).Run(func(args Arguments) {
+		seen = args
+	})
+
+	assert.New(t).NoError(s.Put("foo", "bar"))
+	assert.New(t).Equal(Arguments{"foo", "bar"}, seen)
+}
+
+func TestAssertExpectations(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Get", "foo").Return("bar", nil).Twice()
+	s.On("Put", Anything, Anything).Return(nil).Maybe()
+	s.On("Get", "baz").Return("", nil)
+
+	a, out := newRecordingAssertions()
+	assert.New(t).False(s.AssertExpectations(a))
+	assert.New(t).Contains(out.buf.String(), "2 expectation(s) not met")
+	assert.New(t).Contains(out.buf.String(), `Get("foo"): expected 2 call(s), got 0`)
+	assert.New(t).Contains(out.buf.String(), `Get("baz"): never called`)
+
+	_, _ = s.Get("foo")
+	_, _ = s.Get("foo")
+	_, _ = s.Get("baz")
+	assert.New(t).True(s.AssertExpectations(assert.New(t)))
+}
+
+func TestAssertCalled(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Put", Anything, Anything).Return(nil)
+	assert.New(t).NoError(s.Put("foo", "bar"))
+
+	assert.New(t).True(s.AssertCalled(assert.New(t), "Put", "foo", "bar"))
+	assert.New(t).True(s.AssertCalled(assert.New(t), "Put", "foo", Anything))
+	assert.New(t).True(s.AssertNotCalled(assert.New(t), "Put", "bar", Anything))
+	assert.New(t).True(s.AssertNotCalled(assert.New(t), "Get", Anything))
+
+	a, out := newRecordingAssertions()
+	assert.New(t).False(s.AssertCalled(a, "Put", "foo", "baz"))
+	assert.New(t).Contains(out.buf.String(), `Should have called Put("foo", "baz")`)
+	assert.New(t).Contains(out.buf.String(), `Put("foo", "bar")`)
+
+	a, out = newRecordingAssertions()
+	assert.New(t).False(s.AssertNotCalled(a, "Put", Anything, "bar"))
+	assert.New(t).Contains(out.buf.String(), "Should not have called")
+}
+
+func TestAssertNumberOfCalls(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Put", Anything, Anything).Return(nil)
+	assert.New(t).NoError(s.Put("foo", "bar"))
+	assert.New(t).NoError(s.Put("foo", "baz"))
+
+	assert.New(t).True(s.AssertNumberOfCalls(assert.New(t), "Put", 2))
+	assert.New(t).True(s.AssertNumberOfCalls(assert.New(t), "Get", 0))
+
+	a, out := newRecordingAssertions()
+	assert.New(t).False(s.AssertNumberOfCalls(a, "Put", 1))
+	assert.New(t).Contains(out.buf.String(), "Expected number of calls (1) of Put does not match the actual number of calls (2)")
+}
+
+func TestAssertionsAreHelpers(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Get", "foo").Return("bar", nil)
+
+	a, out := newRecordingAssertions()
+	s.AssertExpectations(a)
+	s.AssertCalled(a, "Get", "foo")
+	s.AssertNotCalled(a, "Get", "foo")
+	s.AssertNumberOfCalls(a, "Get", 1)
+	for _, name := range []string{"AssertExpectations", "AssertCalled", "AssertNotCalled", "AssertNumberOfCalls"} {
+		assert.New(t).True(out.marked("(*Mock)."+name), name)
+	}
+}
+
+func TestFailureAtCaller(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Get", "foo").Return("bar", nil)
+
+	var failure assert.Failure
+	a := assert.New(new(outputT)).WithOnFailureDetail(func(_ assert.TestingT, f assert.Failure) {
+		failure = f
+	})
+	s.AssertExpectations(a)
+	assert.New(t).Equal("AssertExpectations", failure.Assertion)
+}
+
+func TestSourceLine(t *testing.T) {
+	s := new(fakeStore)
+	s.On("Get", "foo").Return("bar", nil)
+
+	a, out := newRecordingAssertions()
+	s.AssertCalled(a.WithSourceLine(), "Get", "foo")
+	assert.New(t).Regexp(`Source:\s+s\.AssertCalled\(a\.WithSourceLine\(\), "Get", "foo"\)`, out.buf.String())
+}
+
+func TestArguments(t *testing.T) {
+	args := Arguments{"foo", 1, true, nil, errors.New("boom")}
+	assert.New(t).Equal("foo", args.String(0))
+	assert.New(t).Equal(1, args.Int(1))
+	assert.New(t).True(args.Bool(2))
+	assert.New(t).NoError(args.Error(3))
+	assert.New(t).EqualError(args.Error(4), "boom")
+	assert.New(t).Panics(func() { args.Get(5) })
+	assert.New(t).Panics(func() { args.Int(0) })
+}
+
+func TestMethodName(t *testing.T) {
+	assert.New(t).Equal("Get", methodName("github.com/tisonkun/assert/mock.(*fakeStore).Get"))
+	assert.New(t).Equal("Get", methodName("github.com/tisonkun/assert/mock.(*fakeStore).Get-fm"))
+}
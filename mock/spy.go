@@ -0,0 +1,163 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tisonkun/assert"
+)
+
+// Invocation is a call recorded by a Spy.
+type Invocation struct {
+	// Args are the arguments of the call. The variadic arguments of a
+	// variadic function are recorded as a single slice.
+	Args []any
+	// Results are the values returned by the call.
+	Results []any
+	// Goroutine is the id of the goroutine that made the call.
+	Goroutine uint64
+	// Time is when the call was made.
+	Time time.Time
+}
+
+// Spy wraps a function value and records every invocation of the wrapper.
+// It is a lightweight alternative to Mock for callbacks.
+//
+//	spy := mock.NewSpy(func(event string) {})
+//	bus.Subscribe(spy.Func())
+//	bus.Publish("created")
+//	mock.CalledWith(a, spy, "created")
+type Spy[T any] struct {
+	mu    sync.Mutex
+	fn    T
+	calls []Invocation
+}
+
+// NewSpy returns a Spy wrapping fn, which must be a function. When fn is a
+// nil function the wrapper returns zero values.
+func NewSpy[T any](fn T) *Spy[T] {
+	fnType := reflect.TypeOf((*T)(nil)).Elem()
+	if fnType.Kind() != reflect.Func {
+		panic(fmt.Sprintf("mock: cannot spy on %v, which is not a function", fnType))
+	}
+
+	s := &Spy[T]{}
+	target := reflect.ValueOf(fn)
+	wrapper := reflect.MakeFunc(fnType, func(in []reflect.Value) []reflect.Value {
+		now := time.Now()
+		var out []reflect.Value
+		if target.IsValid() && !target.IsNil() {
+			if fnType.IsVariadic() {
+				out = target.CallSlice(in)
+			} else {
+				out = target.Call(in)
+			}
+		} else {
+			out = make([]reflect.Value, fnType.NumOut())
+			for i := range out {
+				out[i] = reflect.Zero(fnType.Out(i))
+			}
+		}
+		s.record(Invocation{
+			Args:      interfaces(in),
+			Results:   interfaces(out),
+			Goroutine: goroutineID(),
+			Time:      now,
+		})
+		return out
+	})
+	s.fn = wrapper.Interface().(T)
+	return s
+}
+
+// Func returns the recording wrapper of the spied function.
+func (s *Spy[T]) Func() T {
+	return s.fn
+}
+
+// Calls returns the invocations recorded so far.
+func (s *Spy[T]) Calls() []Invocation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Invocation(nil), s.calls...)
+}
+
+func (s *Spy[T]) record(call Invocation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, call)
+}
+
+// CalledWith asserts that the spied function was called at least once with
+// the given arguments, which may be Anything.
+func CalledWith[T any](a *assert.Assertions, spy *Spy[T], args ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	calls := spy.Calls()
+	for _, call := range calls {
+		if Arguments(args).matches(call.Args) {
+			return true
+		}
+	}
+
+	lines := make([]string, 0, len(calls))
+	for i, call := range calls {
+		lines = append(lines, fmt.Sprintf("#%d (%s)", i, formatArguments(call.Args)))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "(none)")
+	}
+	return a.Fail(fmt.Sprintf("Should have been called with (%s)\n\nActual calls:\n%s", formatArguments(args), strings.Join(lines, "\n")))
+}
+
+// CalledTimes asserts that the spied function was called exactly n times.
+func CalledTimes[T any](a *assert.Assertions, spy *Spy[T], n int, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	if actual := len(spy.Calls()); actual != n {
+		return a.Fail(fmt.Sprintf("Expected %d call(s), but got %d", n, actual), msgAndArgs...)
+	}
+	return true
+}
+
+func interfaces(values []reflect.Value) []any {
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v.Interface()
+	}
+	return result
+}
+
+// goroutineID parses the id of the current goroutine from its stack trace.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tisonkun/assert"
+)
+
+func TestSpy(t *testing.T) {
+	spy := NewSpy(strings.Repeat)
+	fn := spy.Func()
+
+	assert.New(t).Equal("abab", fn("ab", 2))
+	assert.New(t).Equal("", fn("x", 0))
+
+	calls := spy.Calls()
+	assert.New(t).Len(calls, 2)
+	assert.New(t).Equal([]any{"ab", 2}, calls[0].Args)
+	assert.New(t).Equal([]any{"abab"}, calls[0].Results)
+	assert.New(t).Equal(goroutineID(), calls[0].Goroutine)
+	assert.New(t).False(calls[0].Time.IsZero())
+	assert.New(t).False(calls[1].Time.Before(calls[0].Time))
+
+	assert.New(t).True(CalledWith(assert.New(t), spy, "ab", 2))
+	assert.New(t).True(CalledWith(assert.New(t), spy, Anything, 0))
+	assert.New(t).True(CalledTimes(assert.New(t), spy, 2))
+
+	a, out := newRecordingAssertions()
+	assert.New(t).False(CalledWith(a, spy, "ab", 3))
+	assert.New(t).Contains(out.buf.String(), `Should have been called with ("ab", 3)`)
+	assert.New(t).Contains(out.buf.String(), `#0 ("ab", 2)`)
+
+	a, out = newRecordingAssertions()
+	assert.New(t).False(CalledTimes(a, spy, 1))
+	assert.New(t).Contains(out.buf.String(), "Expected 1 call(s), but got 2")
+}
+
+func TestSpyNilAndVariadic(t *testing.T) {
+	var callback func(event string) error
+	spy := NewSpy(callback)
+	assert.New(t).NoError(spy.Func()("created"))
+	assert.New(t).True(CalledWith(assert.New(t), spy, "created"))
+
+	variadic := NewSpy(func(prefix string, values ...int) int { return len(values) })
+	assert.New(t).Equal(3, variadic.Func()("p", 1, 2, 3))
+	assert.New(t).True(CalledWith(assert.New(t), variadic, "p", []int{1, 2, 3}))
+
+	assert.New(t).Panics(func() { NewSpy(42) })
+}
+
+func TestSpyConcurrentCalls(t *testing.T) {
+	spy := NewSpy(func(int) {})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			spy.Func()(i)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.New(t).True(CalledTimes(assert.New(t), spy, 10))
+	goroutines := map[uint64]bool{}
+	for _, call := range spy.Calls() {
+		goroutines[call.Goroutine] = true
+	}
+	assert.New(t).NotContains(goroutines, goroutineID())
+}
+
+func TestSpyAssertionsAreHelpers(t *testing.T) {
+	spy := NewSpy(func(int) {})
+	a, out := newRecordingAssertions()
+	CalledWith(a, spy, 1)
+	CalledTimes(a, spy, 1)
+	assert.New(t).True(out.marked("CalledWith[...]"))
+	assert.New(t).True(out.marked("CalledTimes[...]"))
+}
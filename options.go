@@ -0,0 +1,107 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Option configures the Assertions made by New.
+//
+//	a := assert.New(t, assert.WithMaxDiffLines(50), assert.WithoutErrorTrace())
+type Option func(*Assertions)
+
+// WithMaxDiffLines limits the diffs in failure messages to their first n
+// lines. Zero or less means no limit, the default.
+func WithMaxDiffLines(n int) Option {
+	return func(a *Assertions) {
+		a.maxDiffLines = n
+	}
+}
+
+// WithColor highlights removed and added lines of the diffs in failure
+// messages with ANSI colors. Colors are disabled by default since most CI
+// logs do not render them.
+func WithColor(enabled bool) Option {
+	return func(a *Assertions) {
+		a.color = enabled
+	}
+}
+
+// WithTruncateAt truncates every line of the Error in failure messages to
+// n bytes. Zero or less means no limit, the default.
+func WithTruncateAt(n int) Option {
+	return func(a *Assertions) {
+		a.truncateAt = n
+	}
+}
+
+// WithoutErrorTrace omits the Error Trace from failure messages, e.g. when
+// the test name and messages locate failures well enough.
+func WithoutErrorTrace() Option {
+	return func(a *Assertions) {
+		a.errorTrace.omit = true
+	}
+}
+
+const (
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiCyan  = "\x1b[36m"
+	ansiReset = "\x1b[0m"
+)
+
+// formatDiff applies the diff options to a rendered diff.
+func (a *Assertions) formatDiff(d string) string {
+	lines := strings.Split(d, "\n")
+	if a.maxDiffLines > 0 && len(lines) > a.maxDiffLines {
+		more := len(lines) - a.maxDiffLines
+		lines = append(lines[:a.maxDiffLines:a.maxDiffLines], fmt.Sprintf("... %d more diff line(s)", more))
+	}
+	if a.color {
+		for i, line := range lines {
+			switch {
+			case strings.HasPrefix(line, "-"):
+				lines[i] = ansiRed + line + ansiReset
+			case strings.HasPrefix(line, "+"):
+				lines[i] = ansiGreen + line + ansiReset
+			case strings.HasPrefix(line, "@@"):
+				lines[i] = ansiCyan + line + ansiReset
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// truncateLines truncates every line of s longer than the limit set with
+// WithTruncateAt.
+func (a *Assertions) truncateLines(s string) string {
+	if a.truncateAt <= 0 || len(s) <= a.truncateAt {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if len(line) > a.truncateAt {
+			cut := a.truncateAt
+			for cut > 0 && !utf8.RuneStart(line[cut]) {
+				cut--
+			}
+			lines[i] = line[:cut] + "<... truncated>"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestOptions(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := New(out, WithoutErrorTrace(), WithTruncateAt(20)).WithOnFailure(func(TestingT) {})
+	a.Equal(strings.Repeat("a", 30), "b")
+	New(t).NotContains(out.buf.String(), "Error Trace")
+	New(t).Contains(out.buf.String(), `expected: "aaaaaaaaa<... truncated>`)
+
+	out.buf.Reset()
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	New(out, WithMaxDiffLines(4)).WithOnFailure(func(TestingT) {}).Equal(expected, []int{})
+	New(t).Contains(out.buf.String(), "more diff line(s)")
+
+	out.buf.Reset()
+	New(out, WithColor(true)).WithOnFailure(func(TestingT) {}).Equal([]int{1}, []int{2})
+	New(t).Contains(out.buf.String(), ansiRed+"- (int) 1"+ansiReset)
+	New(t).Contains(out.buf.String(), ansiGreen+"+ (int) 2"+ansiReset)
+}
+
+func TestTruncateLines(t *testing.T) {
+	a := New(t, WithTruncateAt(4))
+	New(t).Equal("abcd\nab", a.truncateLines("abcd\nab"))
+	New(t).Equal("abcd<... truncated>\nab", a.truncateLines("abcdef\nab"))
+	New(t).Equal("abc<... truncated>", a.truncateLines("abcéé"))
+	New(t).Equal("abcdef", New(t).truncateLines("abcdef"))
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package promassert provides assertions on the samples gathered from a
+// Prometheus registry.
+//
+//	promassert.MetricEquals(a, registry, "http_requests_total",
+//		map[string]string{"code": "200"}, 3)
+//
+// Histograms and summaries are addressed by their "_count" and "_sum"
+// series, like in the text exposition format.
+package promassert
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/tisonkun/assert"
+)
+
+type tHelper interface {
+	Helper()
+}
+
+// Gatherer is implemented by prometheus.Gatherer, e.g. *prometheus.Registry.
+type Gatherer interface {
+	Gather() ([]*dto.MetricFamily, error)
+}
+
+// MetricEquals asserts that the sample of the named metric whose labels
+// include the given ones equals expected.
+func MetricEquals(a *assert.Assertions, g Gatherer, name string, labels map[string]string, expected float64, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	actual, ok := sample(a, g, name, labels, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	if actual != expected {
+		return a.Fail(fmt.Sprintf("Metric %s%s has value %v, expected %v", name, formatLabels(labels), actual, expected), msgAndArgs...)
+	}
+	return true
+}
+
+// MetricWithin asserts that the sample of the named metric whose labels
+// include the given ones is within delta of expected.
+func MetricWithin(a *assert.Assertions, g Gatherer, name string, labels map[string]string, expected, delta float64, msgAndArgs ...any) bool {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	actual, ok := sample(a, g, name, labels, msgAndArgs...)
+	if !ok {
+		return false
+	}
+	if math.IsNaN(actual) || math.Abs(actual-expected) > delta {
+		return a.Fail(fmt.Sprintf("Metric %s%s has value %v, expected %v within %v", name, formatLabels(labels), actual, expected, delta), msgAndArgs...)
+	}
+	return true
+}
+
+// sample gathers from g and looks up the value of a single series, reporting
+// a failure if there is no such series or more than one.
+func sample(a *assert.Assertions, g Gatherer, name string, labels map[string]string, msgAndArgs ...any) (float64, bool) {
+	if h, ok := a.T().(tHelper); ok {
+		h.Helper()
+	}
+	families, err := g.Gather()
+	if err != nil {
+		return 0, a.Fail(fmt.Sprintf("Cannot gather metrics: %s", err), msgAndArgs...)
+	}
+
+	family, suffix := findFamily(families, name)
+	if family == nil {
+		names := make([]string, 0, len(families))
+		for _, f := range families {
+			names = append(names, f.GetName())
+		}
+		sort.Strings(names)
+		return 0, a.Fail(fmt.Sprintf("Metric %s not found, available metrics:\n%s", name, strings.Join(names, "\n")), msgAndArgs...)
+	}
+
+	var matched []*dto.Metric
+	var available []string
+	for _, m := range family.GetMetric() {
+		actual := labelMap(m)
+		available = append(available, formatLabels(actual))
+		if hasLabels(actual, labels) {
+			matched = append(matched, m)
+		}
+	}
+	sort.Strings(available)
+
+	switch len(matched) {
+	case 0:
+		return 0, a.Fail(fmt.Sprintf("Metric %s has no series with labels %s, available series:\n%s", name, formatLabels(labels), strings.Join(available, "\n")), msgAndArgs...)
+	case 1:
+	default:
+		return 0, a.Fail(fmt.Sprintf("Metric %s has %d series with labels %s, add labels to select one of:\n%s", name, len(matched), formatLabels(labels), strings.Join(available, "\n")), msgAndArgs...)
+	}
+
+	return value(family.GetType(), matched[0], suffix), true
+}
+
+// findFamily returns the family of the named metric. The "_count" and
+// "_sum" series of histograms and summaries are returned with their suffix.
+func findFamily(families []*dto.MetricFamily, name string) (*dto.MetricFamily, string) {
+	for _, f := range families {
+		if f.GetName() == name {
+			return f, ""
+		}
+	}
+	for _, suffix := range []string{"_count", "_sum"} {
+		base := strings.TrimSuffix(name, suffix)
+		if base == name {
+			continue
+		}
+		for _, f := range families {
+			if f.GetName() != base {
+				continue
+			}
+			switch f.GetType() {
+			case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM, dto.MetricType_SUMMARY:
+				return f, suffix
+			}
+		}
+	}
+	return nil, ""
+}
+
+func value(t dto.MetricType, m *dto.Metric, suffix string) float64 {
+	switch t {
+	case dto.MetricType_COUNTER:
+		return m.GetCounter().GetValue()
+	case dto.MetricType_GAUGE:
+		return m.GetGauge().GetValue()
+	case dto.MetricType_UNTYPED:
+		return m.GetUntyped().GetValue()
+	case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+		if suffix == "_sum" {
+			return m.GetHistogram().GetSampleSum()
+		}
+		return float64(m.GetHistogram().GetSampleCount())
+	case dto.MetricType_SUMMARY:
+		if suffix == "_sum" {
+			return m.GetSummary().GetSampleSum()
+		}
+		return float64(m.GetSummary().GetSampleCount())
+	}
+	return math.NaN()
+}
+
+func labelMap(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.GetLabel()))
+	for _, pair := range m.GetLabel() {
+		labels[pair.GetName()] = pair.GetValue()
+	}
+	return labels
+}
+
+func hasLabels(actual, expected map[string]string) bool {
+	for k, v := range expected {
+		if actual[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, v))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
@@ -0,0 +1,142 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promassert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/tisonkun/assert"
+)
+
+type gathererFunc func() ([]*dto.MetricFamily, error)
+
+func (f gathererFunc) Gather() ([]*dto.MetricFamily, error) {
+	return f()
+}
+
+func str(s string) *string                 { return &s }
+func num(f float64) *float64               { return &f }
+func count(u uint64) *uint64               { return &u }
+func typ(t dto.MetricType) *dto.MetricType { return &t }
+
+func label(name, value string) *dto.LabelPair {
+	return &dto.LabelPair{Name: str(name), Value: str(value)}
+}
+
+var registry = gathererFunc(func() ([]*dto.MetricFamily, error) {
+	return []*dto.MetricFamily{
+		{
+			Name: str("http_requests_total"),
+			Type: typ(dto.MetricType_COUNTER),
+			Metric: []*dto.Metric{
+				{Label: []*dto.LabelPair{label("code", "200"), label("method", "GET")}, Counter: &dto.Counter{Value: num(3)}},
+				{Label: []*dto.LabelPair{label("code", "500"), label("method", "GET")}, Counter: &dto.Counter{Value: num(1)}},
+				{Label: []*dto.LabelPair{label("code", "200"), label("method", "POST")}, Counter: &dto.Counter{Value: num(2)}},
+			},
+		},
+		{
+			Name:   str("temperature"),
+			Type:   typ(dto.MetricType_GAUGE),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: num(21.5)}}},
+		},
+		{
+			Name:   str("latency_seconds"),
+			Type:   typ(dto.MetricType_HISTOGRAM),
+			Metric: []*dto.Metric{{Histogram: &dto.Histogram{SampleCount: count(4), SampleSum: num(1.25)}}},
+		},
+	}, nil
+})
+
+// outputT records failure messages and the functions marked as helpers.
+type outputT struct {
+	buf     bytes.Buffer
+	helpers map[string]bool
+}
+
+func (t *outputT) Helper() {
+	if t.helpers == nil {
+		t.helpers = map[string]bool{}
+	}
+	pc, _, _, _ := runtime.Caller(1)
+	t.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+func (t *outputT) Errorf(format string, args ...any) {
+	t.buf.WriteString(fmt.Sprintf(format, args...))
+}
+
+func (t *outputT) FailNow() {}
+
+func newRecordingAssertions() (*assert.Assertions, *outputT) {
+	out := new(outputT)
+	return assert.New(out).WithOnFailure(func(assert.TestingT) {}), out
+}
+
+func TestMetricEquals(t *testing.T) {
+	a := assert.New(t)
+	a.True(MetricEquals(a, registry, "http_requests_total", map[string]string{"code": "200", "method": "GET"}, 3))
+	a.True(MetricEquals(a, registry, "http_requests_total", map[string]string{"code": "500"}, 1))
+	a.True(MetricEquals(a, registry, "temperature", nil, 21.5))
+	a.True(MetricEquals(a, registry, "latency_seconds_count", nil, 4))
+	a.True(MetricEquals(a, registry, "latency_seconds_sum", nil, 1.25))
+
+	mock, out := newRecordingAssertions()
+	a.False(MetricEquals(mock, registry, "http_requests_total", map[string]string{"code": "500"}, 2))
+	a.Contains(out.buf.String(), `Metric http_requests_total{code="500"} has value 1, expected 2`)
+
+	mock, out = newRecordingAssertions()
+	a.False(MetricEquals(mock, registry, "requests_total", nil, 1))
+	a.Contains(out.buf.String(), "Metric requests_total not found, available metrics:")
+	a.Contains(out.buf.String(), "latency_seconds")
+
+	mock, out = newRecordingAssertions()
+	a.False(MetricEquals(mock, registry, "http_requests_total", map[string]string{"code": "404"}, 1))
+	a.Contains(out.buf.String(), `has no series with labels {code="404"}`)
+	a.Contains(out.buf.String(), `{code="200",method="POST"}`)
+
+	mock, out = newRecordingAssertions()
+	a.False(MetricEquals(mock, registry, "http_requests_total", map[string]string{"code": "200"}, 1))
+	a.Contains(out.buf.String(), "has 2 series")
+
+	mock, out = newRecordingAssertions()
+	a.False(MetricEquals(mock, gathererFunc(func() ([]*dto.MetricFamily, error) {
+		return nil, errors.New("boom")
+	}), "temperature", nil, 1))
+	a.Contains(out.buf.String(), "Cannot gather metrics: boom")
+}
+
+func TestMetricWithin(t *testing.T) {
+	a := assert.New(t)
+	a.True(MetricWithin(a, registry, "temperature", nil, 21, 0.5))
+	a.True(MetricWithin(a, registry, "latency_seconds_sum", nil, 1, 0.3))
+
+	mock, out := newRecordingAssertions()
+	a.False(MetricWithin(mock, registry, "temperature", nil, 20, 1))
+	a.Contains(out.buf.String(), "Metric temperature{} has value 21.5, expected 20 within 1")
+}
+
+func TestHelpers(t *testing.T) {
+	mock, out := newRecordingAssertions()
+	MetricEquals(mock, registry, "missing", nil, 1)
+	MetricWithin(mock, registry, "temperature", nil, 0, 1)
+	for _, name := range []string{"MetricEquals", "MetricWithin", "sample"} {
+		assert.New(t).True(out.helpers["github.com/tisonkun/assert/promassert."+name], name)
+	}
+}
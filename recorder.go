@@ -0,0 +1,89 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// recordingT implements TestingT by recording failure messages instead of
+// reporting them, so that they can be inspected or merged into a single
+// failure later. It is safe for concurrent use.
+type recordingT struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// Errorf records the formatted failure message.
+func (t *recordingT) Errorf(format string, args ...any) {
+	msg := strings.TrimPrefix(fmt.Sprintf(format, args...), "\n")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.messages = append(t.messages, msg)
+}
+
+// FailNow stops the calling goroutine like testing.T.FailNow does. Callers
+// must run code that may call FailNow in its own goroutine.
+func (t *recordingT) FailNow() {
+	runtime.Goexit()
+}
+
+// failures returns a copy of the recorded failure messages.
+func (t *recordingT) failures() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.messages...)
+}
+
+// recordTo returns a copy of the Assertions for a nested check whose
+// failures only go to t, for the enclosing assertion to report them: they
+// are neither deferred, annotated nor emitted to sinks, the hooks and
+// on-failure callbacks do not run, and a failure stops the check.
+func (a *Assertions) recordTo(t TestingT) *Assertions {
+	c := *a
+	c.t = t
+	c.quiet = false
+	c.deferred = nil
+	c.annotations = GitHubAnnotationsOff
+	c.hooks = nil
+	c.onFailure = func(t TestingT) {
+		t.FailNow()
+	}
+	c.onFailureDetail = nil
+	c.nested = true
+	return &c
+}
+
+// runRecorded runs f with an Assertions derived from a that records
+// failures, waits for it to return, and returns the recorded failures. A
+// panic in f is recorded as a failure.
+func runRecorded(a *Assertions, f func(a *Assertions)) []string {
+	recorder := &recordingT{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if funcDidPanic, panicValue, panickedStack := didPanic(func() {
+			f(a.recordTo(recorder))
+		}); funcDidPanic {
+			recorder.Errorf("Panic value:\t%v\nPanic stack:\t%s", panicValue, panickedStack)
+		}
+	}()
+	<-done
+	return recorder.failures()
+}
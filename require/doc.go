@@ -0,0 +1,29 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package require provides the assertions of package assert as functions
+// that take the TestingT as their first argument and stop the test with
+// FailNow on failure, like the require package of testify:
+//
+//	require.NoError(t, err)
+//	require.Equal(t, want, got)
+//
+// The functions are generated from the methods of assert.Assertions.
+package require
+
+//go:generate go run gen.go
+
+type tHelper interface {
+	Helper()
+}
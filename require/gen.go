@@ -0,0 +1,172 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// gen.go generates require.go with a function for every assertion method
+// of assert.Assertions. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "..", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	imports := map[string]string{}
+	used := map[string]bool{"github.com/tisonkun/assert": true}
+	var funcs []*ast.FuncDecl
+	for _, f := range pkgs["assert"].Files {
+		for _, spec := range f.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isAssertion(fn) {
+				funcs = append(funcs, fn)
+			}
+		}
+	}
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].Name.Name < funcs[j].Name.Name })
+
+	var body bytes.Buffer
+	for _, fn := range funcs {
+		var params, args []string
+		for _, field := range fn.Type.Params.List {
+			typ := qualify(fset, field.Type, imports, used)
+			var names []string
+			for _, name := range field.Names {
+				names = append(names, name.Name)
+				if _, ok := field.Type.(*ast.Ellipsis); ok {
+					args = append(args, name.Name+"...")
+				} else {
+					args = append(args, name.Name)
+				}
+			}
+			params = append(params, strings.Join(names, ", ")+" "+typ)
+		}
+		name := fn.Name.Name
+		fmt.Fprintf(&body, `
+// %[1]s asserts like (*assert.Assertions).%[1]s and stops the test on failure.
+func %[1]s(t assert.TestingT, %[2]s) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).%[1]s(%[3]s)
+}
+`, name, strings.Join(params, ", "), strings.Join(args, ", "))
+	}
+
+	var out bytes.Buffer
+	header, err := os.ReadFile("../assertions.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	out.Write(header[:bytes.Index(header, []byte("package"))])
+	out.WriteString("\n// Code generated by gen.go; DO NOT EDIT.\n\npackage require\n\nimport (\n")
+	var std, others []string
+	for path := range used {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	for _, path := range std {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	out.WriteString("\n")
+	for _, path := range others {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	out.WriteString(")\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("require.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isAssertion reports whether fn is an exported method of *Assertions that
+// returns a single bool.
+func isAssertion(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || !fn.Name.IsExported() || fn.Type.Results == nil {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	if recv, ok := star.X.(*ast.Ident); !ok || recv.Name != "Assertions" {
+		return false
+	}
+	results := fn.Type.Results.List
+	if len(results) != 1 || len(results[0].Names) > 1 {
+		return false
+	}
+	result, ok := results[0].Type.(*ast.Ident)
+	return ok && result.Name == "bool"
+}
+
+// qualify prints a type expression of package assert as seen from package
+// require, recording the imports it uses.
+func qualify(fset *token.FileSet, expr ast.Expr, imports map[string]string, used map[string]bool) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if pkg, ok := n.X.(*ast.Ident); ok {
+				used[imports[pkg.Name]] = true
+			}
+			return false
+		case *ast.Ident:
+			if n.IsExported() {
+				n.Name = "assert." + n.Name
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,1851 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by gen.go; DO NOT EDIT.
+
+package require
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/tisonkun/assert"
+)
+
+// After asserts like (*assert.Assertions).After and stops the test on failure.
+func After(t assert.TestingT, t1, t2 time.Time, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).After(t1, t2, msgAndArgs...)
+}
+
+// Afterf asserts like (*assert.Assertions).Afterf and stops the test on failure.
+func Afterf(t assert.TestingT, t1, t2 time.Time, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Afterf(t1, t2, msg, args...)
+}
+
+// AllExportedFieldsNotZero asserts like (*assert.Assertions).AllExportedFieldsNotZero and stops the test on failure.
+func AllExportedFieldsNotZero(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AllExportedFieldsNotZero(object, msgAndArgs...)
+}
+
+// AllExportedFieldsNotZerof asserts like (*assert.Assertions).AllExportedFieldsNotZerof and stops the test on failure.
+func AllExportedFieldsNotZerof(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AllExportedFieldsNotZerof(object, msg, args...)
+}
+
+// AllFieldsTagged asserts like (*assert.Assertions).AllFieldsTagged and stops the test on failure.
+func AllFieldsTagged(t assert.TestingT, object any, key string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AllFieldsTagged(object, key, msgAndArgs...)
+}
+
+// AllFieldsTaggedf asserts like (*assert.Assertions).AllFieldsTaggedf and stops the test on failure.
+func AllFieldsTaggedf(t assert.TestingT, object any, key string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AllFieldsTaggedf(object, key, msg, args...)
+}
+
+// AllMatch asserts like (*assert.Assertions).AllMatch and stops the test on failure.
+func AllMatch(t assert.TestingT, list any, predicate func(el any) bool, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AllMatch(list, predicate, msgAndArgs...)
+}
+
+// AllMatchf asserts like (*assert.Assertions).AllMatchf and stops the test on failure.
+func AllMatchf(t assert.TestingT, list any, predicate func(el any) bool, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AllMatchf(list, predicate, msg, args...)
+}
+
+// AnyElement asserts like (*assert.Assertions).AnyElement and stops the test on failure.
+func AnyElement(t assert.TestingT, list any, predicate func(el any) bool, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AnyElement(list, predicate, msgAndArgs...)
+}
+
+// AnyElementf asserts like (*assert.Assertions).AnyElementf and stops the test on failure.
+func AnyElementf(t assert.TestingT, list any, predicate func(el any) bool, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).AnyElementf(list, predicate, msg, args...)
+}
+
+// Before asserts like (*assert.Assertions).Before and stops the test on failure.
+func Before(t assert.TestingT, t1, t2 time.Time, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Before(t1, t2, msgAndArgs...)
+}
+
+// Beforef asserts like (*assert.Assertions).Beforef and stops the test on failure.
+func Beforef(t assert.TestingT, t1, t2 time.Time, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Beforef(t1, t2, msg, args...)
+}
+
+// Blank asserts like (*assert.Assertions).Blank and stops the test on failure.
+func Blank(t assert.TestingT, s string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Blank(s, msgAndArgs...)
+}
+
+// Blankf asserts like (*assert.Assertions).Blankf and stops the test on failure.
+func Blankf(t assert.TestingT, s string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Blankf(s, msg, args...)
+}
+
+// Concurrently asserts like (*assert.Assertions).Concurrently and stops the test on failure.
+func Concurrently(t assert.TestingT, n int, body func(i int, a *assert.Assertions), msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Concurrently(n, body, msgAndArgs...)
+}
+
+// Concurrentlyf asserts like (*assert.Assertions).Concurrentlyf and stops the test on failure.
+func Concurrentlyf(t assert.TestingT, n int, body func(i int, a *assert.Assertions), msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Concurrentlyf(n, body, msg, args...)
+}
+
+// Condition asserts like (*assert.Assertions).Condition and stops the test on failure.
+func Condition(t assert.TestingT, comp assert.Comparison, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Condition(comp, msgAndArgs...)
+}
+
+// Conditionf asserts like (*assert.Assertions).Conditionf and stops the test on failure.
+func Conditionf(t assert.TestingT, comp assert.Comparison, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Conditionf(comp, msg, args...)
+}
+
+// Contains asserts like (*assert.Assertions).Contains and stops the test on failure.
+func Contains(t assert.TestingT, s, contains any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Contains(s, contains, msgAndArgs...)
+}
+
+// Containsf asserts like (*assert.Assertions).Containsf and stops the test on failure.
+func Containsf(t assert.TestingT, s, contains any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Containsf(s, contains, msg, args...)
+}
+
+// Defer asserts like (*assert.Assertions).Defer and stops the test on failure.
+func Defer(t assert.TestingT, f func(a *assert.Assertions)) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Defer(f)
+}
+
+// DirExists asserts like (*assert.Assertions).DirExists and stops the test on failure.
+func DirExists(t assert.TestingT, path string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).DirExists(path, msgAndArgs...)
+}
+
+// DirExistsf asserts like (*assert.Assertions).DirExistsf and stops the test on failure.
+func DirExistsf(t assert.TestingT, path string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).DirExistsf(path, msg, args...)
+}
+
+// ElementsMatch asserts like (*assert.Assertions).ElementsMatch and stops the test on failure.
+func ElementsMatch(t assert.TestingT, listA, listB any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ElementsMatch(listA, listB, msgAndArgs...)
+}
+
+// ElementsMatchf asserts like (*assert.Assertions).ElementsMatchf and stops the test on failure.
+func ElementsMatchf(t assert.TestingT, listA, listB any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ElementsMatchf(listA, listB, msg, args...)
+}
+
+// Empty asserts like (*assert.Assertions).Empty and stops the test on failure.
+func Empty(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Empty(object, msgAndArgs...)
+}
+
+// Emptyf asserts like (*assert.Assertions).Emptyf and stops the test on failure.
+func Emptyf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Emptyf(object, msg, args...)
+}
+
+// Equal asserts like (*assert.Assertions).Equal and stops the test on failure.
+func Equal(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Equal(expected, actual, msgAndArgs...)
+}
+
+// EqualError asserts like (*assert.Assertions).EqualError and stops the test on failure.
+func EqualError(t assert.TestingT, theError error, errString string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualError(theError, errString, msgAndArgs...)
+}
+
+// EqualErrorf asserts like (*assert.Assertions).EqualErrorf and stops the test on failure.
+func EqualErrorf(t assert.TestingT, theError error, errString string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualErrorf(theError, errString, msg, args...)
+}
+
+// EqualIgnoringLineEndings asserts like (*assert.Assertions).EqualIgnoringLineEndings and stops the test on failure.
+func EqualIgnoringLineEndings(t assert.TestingT, expected, actual string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualIgnoringLineEndings(expected, actual, msgAndArgs...)
+}
+
+// EqualIgnoringLineEndingsf asserts like (*assert.Assertions).EqualIgnoringLineEndingsf and stops the test on failure.
+func EqualIgnoringLineEndingsf(t assert.TestingT, expected, actual string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualIgnoringLineEndingsf(expected, actual, msg, args...)
+}
+
+// EqualSortedBy asserts like (*assert.Assertions).EqualSortedBy and stops the test on failure.
+func EqualSortedBy(t assert.TestingT, expected, actual any, key func(el any) any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualSortedBy(expected, actual, key, msgAndArgs...)
+}
+
+// EqualSortedByf asserts like (*assert.Assertions).EqualSortedByf and stops the test on failure.
+func EqualSortedByf(t assert.TestingT, expected, actual any, key func(el any) any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualSortedByf(expected, actual, key, msg, args...)
+}
+
+// EqualValues asserts like (*assert.Assertions).EqualValues and stops the test on failure.
+func EqualValues(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualValues(expected, actual, msgAndArgs...)
+}
+
+// EqualValuesf asserts like (*assert.Assertions).EqualValuesf and stops the test on failure.
+func EqualValuesf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EqualValuesf(expected, actual, msg, args...)
+}
+
+// Equalf asserts like (*assert.Assertions).Equalf and stops the test on failure.
+func Equalf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Equalf(expected, actual, msg, args...)
+}
+
+// Error asserts like (*assert.Assertions).Error and stops the test on failure.
+func Error(t assert.TestingT, err error, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Error(err, msgAndArgs...)
+}
+
+// ErrorAs asserts like (*assert.Assertions).ErrorAs and stops the test on failure.
+func ErrorAs(t assert.TestingT, err error, target any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorAs(err, target, msgAndArgs...)
+}
+
+// ErrorAsf asserts like (*assert.Assertions).ErrorAsf and stops the test on failure.
+func ErrorAsf(t assert.TestingT, err error, target any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorAsf(err, target, msg, args...)
+}
+
+// ErrorContains asserts like (*assert.Assertions).ErrorContains and stops the test on failure.
+func ErrorContains(t assert.TestingT, theError error, contains string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorContains(theError, contains, msgAndArgs...)
+}
+
+// ErrorContainsf asserts like (*assert.Assertions).ErrorContainsf and stops the test on failure.
+func ErrorContainsf(t assert.TestingT, theError error, contains string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorContainsf(theError, contains, msg, args...)
+}
+
+// ErrorIs asserts like (*assert.Assertions).ErrorIs and stops the test on failure.
+func ErrorIs(t assert.TestingT, err, target error, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorIs(err, target, msgAndArgs...)
+}
+
+// ErrorIsf asserts like (*assert.Assertions).ErrorIsf and stops the test on failure.
+func ErrorIsf(t assert.TestingT, err, target error, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorIsf(err, target, msg, args...)
+}
+
+// ErrorRegexp asserts like (*assert.Assertions).ErrorRegexp and stops the test on failure.
+func ErrorRegexp(t assert.TestingT, theError error, rx any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorRegexp(theError, rx, msgAndArgs...)
+}
+
+// ErrorRegexpf asserts like (*assert.Assertions).ErrorRegexpf and stops the test on failure.
+func ErrorRegexpf(t assert.TestingT, theError error, rx any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ErrorRegexpf(theError, rx, msg, args...)
+}
+
+// Eventually asserts like (*assert.Assertions).Eventually and stops the test on failure.
+func Eventually(t assert.TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Eventually(condition, waitFor, tick, msgAndArgs...)
+}
+
+// EventuallyContext asserts like (*assert.Assertions).EventuallyContext and stops the test on failure.
+func EventuallyContext(t assert.TestingT, condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EventuallyContext(condition, waitFor, tick, msgAndArgs...)
+}
+
+// EventuallyContextf asserts like (*assert.Assertions).EventuallyContextf and stops the test on failure.
+func EventuallyContextf(t assert.TestingT, condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EventuallyContextf(condition, waitFor, tick, msg, args...)
+}
+
+// EventuallyIncreasing asserts like (*assert.Assertions).EventuallyIncreasing and stops the test on failure.
+func EventuallyIncreasing(t assert.TestingT, getter func() float64, samples int, interval time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EventuallyIncreasing(getter, samples, interval, msgAndArgs...)
+}
+
+// EventuallyIncreasingf asserts like (*assert.Assertions).EventuallyIncreasingf and stops the test on failure.
+func EventuallyIncreasingf(t assert.TestingT, getter func() float64, samples int, interval time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EventuallyIncreasingf(getter, samples, interval, msg, args...)
+}
+
+// EventuallyNonDecreasing asserts like (*assert.Assertions).EventuallyNonDecreasing and stops the test on failure.
+func EventuallyNonDecreasing(t assert.TestingT, getter func() float64, samples int, interval time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EventuallyNonDecreasing(getter, samples, interval, msgAndArgs...)
+}
+
+// EventuallyNonDecreasingf asserts like (*assert.Assertions).EventuallyNonDecreasingf and stops the test on failure.
+func EventuallyNonDecreasingf(t assert.TestingT, getter func() float64, samples int, interval time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EventuallyNonDecreasingf(getter, samples, interval, msg, args...)
+}
+
+// Eventuallyf asserts like (*assert.Assertions).Eventuallyf and stops the test on failure.
+func Eventuallyf(t assert.TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Eventuallyf(condition, waitFor, tick, msg, args...)
+}
+
+// EveryElement asserts like (*assert.Assertions).EveryElement and stops the test on failure.
+func EveryElement(t assert.TestingT, list any, assertion func(a *assert.Assertions, el any), msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EveryElement(list, assertion, msgAndArgs...)
+}
+
+// EveryElementf asserts like (*assert.Assertions).EveryElementf and stops the test on failure.
+func EveryElementf(t assert.TestingT, list any, assertion func(a *assert.Assertions, el any), msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).EveryElementf(list, assertion, msg, args...)
+}
+
+// Exactly asserts like (*assert.Assertions).Exactly and stops the test on failure.
+func Exactly(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Exactly(expected, actual, msgAndArgs...)
+}
+
+// ExactlySameType asserts like (*assert.Assertions).ExactlySameType and stops the test on failure.
+func ExactlySameType(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ExactlySameType(expected, actual, msgAndArgs...)
+}
+
+// ExactlySameTypef asserts like (*assert.Assertions).ExactlySameTypef and stops the test on failure.
+func ExactlySameTypef(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ExactlySameTypef(expected, actual, msg, args...)
+}
+
+// Exactlyf asserts like (*assert.Assertions).Exactlyf and stops the test on failure.
+func Exactlyf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Exactlyf(expected, actual, msg, args...)
+}
+
+// Fail asserts like (*assert.Assertions).Fail and stops the test on failure.
+func Fail(t assert.TestingT, failureMessage string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Fail(failureMessage, msgAndArgs...)
+}
+
+// FailNow asserts like (*assert.Assertions).FailNow and stops the test on failure.
+func FailNow(t assert.TestingT, failureMessage string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FailNow(failureMessage, msgAndArgs...)
+}
+
+// FailNowf asserts like (*assert.Assertions).FailNowf and stops the test on failure.
+func FailNowf(t assert.TestingT, failureMessage string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FailNowf(failureMessage, msg, args...)
+}
+
+// Failf asserts like (*assert.Assertions).Failf and stops the test on failure.
+func Failf(t assert.TestingT, failureMessage string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Failf(failureMessage, msg, args...)
+}
+
+// False asserts like (*assert.Assertions).False and stops the test on failure.
+func False(t assert.TestingT, value bool, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).False(value, msgAndArgs...)
+}
+
+// Falsef asserts like (*assert.Assertions).Falsef and stops the test on failure.
+func Falsef(t assert.TestingT, value bool, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Falsef(value, msg, args...)
+}
+
+// FieldEqual asserts like (*assert.Assertions).FieldEqual and stops the test on failure.
+func FieldEqual(t assert.TestingT, object any, path string, expected any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FieldEqual(object, path, expected, msgAndArgs...)
+}
+
+// FieldEqualf asserts like (*assert.Assertions).FieldEqualf and stops the test on failure.
+func FieldEqualf(t assert.TestingT, object any, path string, expected any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FieldEqualf(object, path, expected, msg, args...)
+}
+
+// FieldsNotZero asserts like (*assert.Assertions).FieldsNotZero and stops the test on failure.
+func FieldsNotZero(t assert.TestingT, object any, paths []string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FieldsNotZero(object, paths, msgAndArgs...)
+}
+
+// FieldsNotZerof asserts like (*assert.Assertions).FieldsNotZerof and stops the test on failure.
+func FieldsNotZerof(t assert.TestingT, object any, paths []string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FieldsNotZerof(object, paths, msg, args...)
+}
+
+// FileExists asserts like (*assert.Assertions).FileExists and stops the test on failure.
+func FileExists(t assert.TestingT, path string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FileExists(path, msgAndArgs...)
+}
+
+// FileExistsf asserts like (*assert.Assertions).FileExistsf and stops the test on failure.
+func FileExistsf(t assert.TestingT, path string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FileExistsf(path, msg, args...)
+}
+
+// FinallyNoError asserts like (*assert.Assertions).FinallyNoError and stops the test on failure.
+func FinallyNoError(t assert.TestingT, f func() error, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FinallyNoError(f, msgAndArgs...)
+}
+
+// FinallyNoErrorf asserts like (*assert.Assertions).FinallyNoErrorf and stops the test on failure.
+func FinallyNoErrorf(t assert.TestingT, f func() error, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FinallyNoErrorf(f, msg, args...)
+}
+
+// FinallyNotNil asserts like (*assert.Assertions).FinallyNotNil and stops the test on failure.
+func FinallyNotNil(t assert.TestingT, f func() any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FinallyNotNil(f, msgAndArgs...)
+}
+
+// FinallyNotNilf asserts like (*assert.Assertions).FinallyNotNilf and stops the test on failure.
+func FinallyNotNilf(t assert.TestingT, f func() any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).FinallyNotNilf(f, msg, args...)
+}
+
+// ForAll asserts like (*assert.Assertions).ForAll and stops the test on failure.
+func ForAll(t assert.TestingT, generator func(r *rand.Rand) any, property func(a *assert.Assertions, v any), opts ...assert.ForAllOption) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ForAll(generator, property, opts...)
+}
+
+// GobRoundTrips asserts like (*assert.Assertions).GobRoundTrips and stops the test on failure.
+func GobRoundTrips(t assert.TestingT, value any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).GobRoundTrips(value, msgAndArgs...)
+}
+
+// GobRoundTripsf asserts like (*assert.Assertions).GobRoundTripsf and stops the test on failure.
+func GobRoundTripsf(t assert.TestingT, value any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).GobRoundTripsf(value, msg, args...)
+}
+
+// Greater asserts like (*assert.Assertions).Greater and stops the test on failure.
+func Greater(t assert.TestingT, e1 any, e2 any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Greater(e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqual asserts like (*assert.Assertions).GreaterOrEqual and stops the test on failure.
+func GreaterOrEqual(t assert.TestingT, e1 any, e2 any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).GreaterOrEqual(e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqualf asserts like (*assert.Assertions).GreaterOrEqualf and stops the test on failure.
+func GreaterOrEqualf(t assert.TestingT, e1 any, e2 any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).GreaterOrEqualf(e1, e2, msg, args...)
+}
+
+// Greaterf asserts like (*assert.Assertions).Greaterf and stops the test on failure.
+func Greaterf(t assert.TestingT, e1 any, e2 any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Greaterf(e1, e2, msg, args...)
+}
+
+// HasStructTag asserts like (*assert.Assertions).HasStructTag and stops the test on failure.
+func HasStructTag(t assert.TestingT, object any, fieldName, key, value string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).HasStructTag(object, fieldName, key, value, msgAndArgs...)
+}
+
+// HasStructTagf asserts like (*assert.Assertions).HasStructTagf and stops the test on failure.
+func HasStructTagf(t assert.TestingT, object any, fieldName, key, value string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).HasStructTagf(object, fieldName, key, value, msg, args...)
+}
+
+// HistogramMatches asserts like (*assert.Assertions).HistogramMatches and stops the test on failure.
+func HistogramMatches(t assert.TestingT, samples any, buckets, expectedCounts []float64, tolerance float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).HistogramMatches(samples, buckets, expectedCounts, tolerance, msgAndArgs...)
+}
+
+// HistogramMatchesf asserts like (*assert.Assertions).HistogramMatchesf and stops the test on failure.
+func HistogramMatchesf(t assert.TestingT, samples any, buckets, expectedCounts []float64, tolerance float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).HistogramMatchesf(samples, buckets, expectedCounts, tolerance, msg, args...)
+}
+
+// Implements asserts like (*assert.Assertions).Implements and stops the test on failure.
+func Implements(t assert.TestingT, interfaceObject any, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Implements(interfaceObject, object, msgAndArgs...)
+}
+
+// Implementsf asserts like (*assert.Assertions).Implementsf and stops the test on failure.
+func Implementsf(t assert.TestingT, interfaceObject any, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Implementsf(interfaceObject, object, msg, args...)
+}
+
+// InDelta asserts like (*assert.Assertions).InDelta and stops the test on failure.
+func InDelta(t assert.TestingT, expected, actual any, delta float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDelta(expected, actual, delta, msgAndArgs...)
+}
+
+// InDelta2D asserts like (*assert.Assertions).InDelta2D and stops the test on failure.
+func InDelta2D(t assert.TestingT, expected, actual [][]float64, delta float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDelta2D(expected, actual, delta, msgAndArgs...)
+}
+
+// InDelta2Df asserts like (*assert.Assertions).InDelta2Df and stops the test on failure.
+func InDelta2Df(t assert.TestingT, expected, actual [][]float64, delta float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDelta2Df(expected, actual, delta, msg, args...)
+}
+
+// InDeltaMapValues asserts like (*assert.Assertions).InDeltaMapValues and stops the test on failure.
+func InDeltaMapValues(t assert.TestingT, expected, actual any, delta float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDeltaMapValues(expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaMapValuesf asserts like (*assert.Assertions).InDeltaMapValuesf and stops the test on failure.
+func InDeltaMapValuesf(t assert.TestingT, expected, actual any, delta float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDeltaMapValuesf(expected, actual, delta, msg, args...)
+}
+
+// InDeltaSlice asserts like (*assert.Assertions).InDeltaSlice and stops the test on failure.
+func InDeltaSlice(t assert.TestingT, expected, actual any, delta float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDeltaSlice(expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaSlicef asserts like (*assert.Assertions).InDeltaSlicef and stops the test on failure.
+func InDeltaSlicef(t assert.TestingT, expected, actual any, delta float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDeltaSlicef(expected, actual, delta, msg, args...)
+}
+
+// InDeltaf asserts like (*assert.Assertions).InDeltaf and stops the test on failure.
+func InDeltaf(t assert.TestingT, expected, actual any, delta float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InDeltaf(expected, actual, delta, msg, args...)
+}
+
+// InEpsilon asserts like (*assert.Assertions).InEpsilon and stops the test on failure.
+func InEpsilon(t assert.TestingT, expected, actual any, epsilon float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InEpsilon(expected, actual, epsilon, msgAndArgs...)
+}
+
+// InEpsilonSlice asserts like (*assert.Assertions).InEpsilonSlice and stops the test on failure.
+func InEpsilonSlice(t assert.TestingT, expected, actual any, epsilon float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InEpsilonSlice(expected, actual, epsilon, msgAndArgs...)
+}
+
+// InEpsilonSlicef asserts like (*assert.Assertions).InEpsilonSlicef and stops the test on failure.
+func InEpsilonSlicef(t assert.TestingT, expected, actual any, epsilon float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InEpsilonSlicef(expected, actual, epsilon, msg, args...)
+}
+
+// InEpsilonf asserts like (*assert.Assertions).InEpsilonf and stops the test on failure.
+func InEpsilonf(t assert.TestingT, expected, actual any, epsilon float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InEpsilonf(expected, actual, epsilon, msg, args...)
+}
+
+// InOpenRange asserts like (*assert.Assertions).InOpenRange and stops the test on failure.
+func InOpenRange(t assert.TestingT, value, min, max any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InOpenRange(value, min, max, msgAndArgs...)
+}
+
+// InOpenRangef asserts like (*assert.Assertions).InOpenRangef and stops the test on failure.
+func InOpenRangef(t assert.TestingT, value, min, max any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InOpenRangef(value, min, max, msg, args...)
+}
+
+// InRange asserts like (*assert.Assertions).InRange and stops the test on failure.
+func InRange(t assert.TestingT, value, min, max any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InRange(value, min, max, msgAndArgs...)
+}
+
+// InRangef asserts like (*assert.Assertions).InRangef and stops the test on failure.
+func InRangef(t assert.TestingT, value, min, max any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).InRangef(value, min, max, msg, args...)
+}
+
+// IsDecreasing asserts like (*assert.Assertions).IsDecreasing and stops the test on failure.
+func IsDecreasing(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsDecreasing(object, msgAndArgs...)
+}
+
+// IsDecreasingf asserts like (*assert.Assertions).IsDecreasingf and stops the test on failure.
+func IsDecreasingf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsDecreasingf(object, msg, args...)
+}
+
+// IsIncreasing asserts like (*assert.Assertions).IsIncreasing and stops the test on failure.
+func IsIncreasing(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsIncreasing(object, msgAndArgs...)
+}
+
+// IsIncreasingf asserts like (*assert.Assertions).IsIncreasingf and stops the test on failure.
+func IsIncreasingf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsIncreasingf(object, msg, args...)
+}
+
+// IsKind asserts like (*assert.Assertions).IsKind and stops the test on failure.
+func IsKind(t assert.TestingT, expectedKind reflect.Kind, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsKind(expectedKind, object, msgAndArgs...)
+}
+
+// IsKindf asserts like (*assert.Assertions).IsKindf and stops the test on failure.
+func IsKindf(t assert.TestingT, expectedKind reflect.Kind, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsKindf(expectedKind, object, msg, args...)
+}
+
+// IsNonDecreasing asserts like (*assert.Assertions).IsNonDecreasing and stops the test on failure.
+func IsNonDecreasing(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsNonDecreasing(object, msgAndArgs...)
+}
+
+// IsNonDecreasingf asserts like (*assert.Assertions).IsNonDecreasingf and stops the test on failure.
+func IsNonDecreasingf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsNonDecreasingf(object, msg, args...)
+}
+
+// IsNonIncreasing asserts like (*assert.Assertions).IsNonIncreasing and stops the test on failure.
+func IsNonIncreasing(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsNonIncreasing(object, msgAndArgs...)
+}
+
+// IsNonIncreasingf asserts like (*assert.Assertions).IsNonIncreasingf and stops the test on failure.
+func IsNonIncreasingf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsNonIncreasingf(object, msg, args...)
+}
+
+// IsType asserts like (*assert.Assertions).IsType and stops the test on failure.
+func IsType(t assert.TestingT, expectedType any, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsType(expectedType, object, msgAndArgs...)
+}
+
+// IsTypef asserts like (*assert.Assertions).IsTypef and stops the test on failure.
+func IsTypef(t assert.TestingT, expectedType any, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).IsTypef(expectedType, object, msg, args...)
+}
+
+// JSONEq asserts like (*assert.Assertions).JSONEq and stops the test on failure.
+func JSONEq(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).JSONEq(expected, actual, msgAndArgs...)
+}
+
+// JSONEqf asserts like (*assert.Assertions).JSONEqf and stops the test on failure.
+func JSONEqf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).JSONEqf(expected, actual, msg, args...)
+}
+
+// JSONLinesEq asserts like (*assert.Assertions).JSONLinesEq and stops the test on failure.
+func JSONLinesEq(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).JSONLinesEq(expected, actual, msgAndArgs...)
+}
+
+// JSONLinesEqf asserts like (*assert.Assertions).JSONLinesEqf and stops the test on failure.
+func JSONLinesEqf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).JSONLinesEqf(expected, actual, msg, args...)
+}
+
+// JSONRoundTrips asserts like (*assert.Assertions).JSONRoundTrips and stops the test on failure.
+func JSONRoundTrips(t assert.TestingT, value any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).JSONRoundTrips(value, msgAndArgs...)
+}
+
+// JSONRoundTripsf asserts like (*assert.Assertions).JSONRoundTripsf and stops the test on failure.
+func JSONRoundTripsf(t assert.TestingT, value any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).JSONRoundTripsf(value, msg, args...)
+}
+
+// Len asserts like (*assert.Assertions).Len and stops the test on failure.
+func Len(t assert.TestingT, object any, length int, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Len(object, length, msgAndArgs...)
+}
+
+// LenBetween asserts like (*assert.Assertions).LenBetween and stops the test on failure.
+func LenBetween(t assert.TestingT, object any, min, max int, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LenBetween(object, min, max, msgAndArgs...)
+}
+
+// LenBetweenf asserts like (*assert.Assertions).LenBetweenf and stops the test on failure.
+func LenBetweenf(t assert.TestingT, object any, min, max int, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LenBetweenf(object, min, max, msg, args...)
+}
+
+// LenGreater asserts like (*assert.Assertions).LenGreater and stops the test on failure.
+func LenGreater(t assert.TestingT, object any, n int, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LenGreater(object, n, msgAndArgs...)
+}
+
+// LenGreaterf asserts like (*assert.Assertions).LenGreaterf and stops the test on failure.
+func LenGreaterf(t assert.TestingT, object any, n int, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LenGreaterf(object, n, msg, args...)
+}
+
+// LenLess asserts like (*assert.Assertions).LenLess and stops the test on failure.
+func LenLess(t assert.TestingT, object any, n int, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LenLess(object, n, msgAndArgs...)
+}
+
+// LenLessf asserts like (*assert.Assertions).LenLessf and stops the test on failure.
+func LenLessf(t assert.TestingT, object any, n int, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LenLessf(object, n, msg, args...)
+}
+
+// Lenf asserts like (*assert.Assertions).Lenf and stops the test on failure.
+func Lenf(t assert.TestingT, object any, length int, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Lenf(object, length, msg, args...)
+}
+
+// Less asserts like (*assert.Assertions).Less and stops the test on failure.
+func Less(t assert.TestingT, e1 any, e2 any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Less(e1, e2, msgAndArgs...)
+}
+
+// LessOrEqual asserts like (*assert.Assertions).LessOrEqual and stops the test on failure.
+func LessOrEqual(t assert.TestingT, e1 any, e2 any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LessOrEqual(e1, e2, msgAndArgs...)
+}
+
+// LessOrEqualf asserts like (*assert.Assertions).LessOrEqualf and stops the test on failure.
+func LessOrEqualf(t assert.TestingT, e1 any, e2 any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).LessOrEqualf(e1, e2, msg, args...)
+}
+
+// Lessf asserts like (*assert.Assertions).Lessf and stops the test on failure.
+func Lessf(t assert.TestingT, e1 any, e2 any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Lessf(e1, e2, msg, args...)
+}
+
+// MatchedBy asserts like (*assert.Assertions).MatchedBy and stops the test on failure.
+func MatchedBy(t assert.TestingT, actual any, matcher assert.Matcher, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).MatchedBy(actual, matcher, msgAndArgs...)
+}
+
+// MatchedByf asserts like (*assert.Assertions).MatchedByf and stops the test on failure.
+func MatchedByf(t assert.TestingT, actual any, matcher assert.Matcher, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).MatchedByf(actual, matcher, msg, args...)
+}
+
+// MeanInDelta asserts like (*assert.Assertions).MeanInDelta and stops the test on failure.
+func MeanInDelta(t assert.TestingT, samples any, expected, delta float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).MeanInDelta(samples, expected, delta, msgAndArgs...)
+}
+
+// MeanInDeltaf asserts like (*assert.Assertions).MeanInDeltaf and stops the test on failure.
+func MeanInDeltaf(t assert.TestingT, samples any, expected, delta float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).MeanInDeltaf(samples, expected, delta, msg, args...)
+}
+
+// MutexUnlockedWithin asserts like (*assert.Assertions).MutexUnlockedWithin and stops the test on failure.
+func MutexUnlockedWithin(t assert.TestingT, m sync.Locker, timeout time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).MutexUnlockedWithin(m, timeout, msgAndArgs...)
+}
+
+// MutexUnlockedWithinf asserts like (*assert.Assertions).MutexUnlockedWithinf and stops the test on failure.
+func MutexUnlockedWithinf(t assert.TestingT, m sync.Locker, timeout time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).MutexUnlockedWithinf(m, timeout, msg, args...)
+}
+
+// Negative asserts like (*assert.Assertions).Negative and stops the test on failure.
+func Negative(t assert.TestingT, e any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Negative(e, msgAndArgs...)
+}
+
+// Negativef asserts like (*assert.Assertions).Negativef and stops the test on failure.
+func Negativef(t assert.TestingT, e any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Negativef(e, msg, args...)
+}
+
+// Never asserts like (*assert.Assertions).Never and stops the test on failure.
+func Never(t assert.TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Never(condition, waitFor, tick, msgAndArgs...)
+}
+
+// NeverContext asserts like (*assert.Assertions).NeverContext and stops the test on failure.
+func NeverContext(t assert.TestingT, condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NeverContext(condition, waitFor, tick, msgAndArgs...)
+}
+
+// NeverContextf asserts like (*assert.Assertions).NeverContextf and stops the test on failure.
+func NeverContextf(t assert.TestingT, condition func(ctx context.Context) bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NeverContextf(condition, waitFor, tick, msg, args...)
+}
+
+// Neverf asserts like (*assert.Assertions).Neverf and stops the test on failure.
+func Neverf(t assert.TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Neverf(condition, waitFor, tick, msg, args...)
+}
+
+// Nil asserts like (*assert.Assertions).Nil and stops the test on failure.
+func Nil(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Nil(object, msgAndArgs...)
+}
+
+// Nilf asserts like (*assert.Assertions).Nilf and stops the test on failure.
+func Nilf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Nilf(object, msg, args...)
+}
+
+// NoDirExists asserts like (*assert.Assertions).NoDirExists and stops the test on failure.
+func NoDirExists(t assert.TestingT, path string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoDirExists(path, msgAndArgs...)
+}
+
+// NoDirExistsf asserts like (*assert.Assertions).NoDirExistsf and stops the test on failure.
+func NoDirExistsf(t assert.TestingT, path string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoDirExistsf(path, msg, args...)
+}
+
+// NoError asserts like (*assert.Assertions).NoError and stops the test on failure.
+func NoError(t assert.TestingT, err error, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoError(err, msgAndArgs...)
+}
+
+// NoErrorGroup asserts like (*assert.Assertions).NoErrorGroup and stops the test on failure.
+func NoErrorGroup(t assert.TestingT, g assert.ErrorGroup, timeout time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoErrorGroup(g, timeout, msgAndArgs...)
+}
+
+// NoErrorGroupf asserts like (*assert.Assertions).NoErrorGroupf and stops the test on failure.
+func NoErrorGroupf(t assert.TestingT, g assert.ErrorGroup, timeout time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoErrorGroupf(g, timeout, msg, args...)
+}
+
+// NoErrorf asserts like (*assert.Assertions).NoErrorf and stops the test on failure.
+func NoErrorf(t assert.TestingT, err error, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoErrorf(err, msg, args...)
+}
+
+// NoFDLeak asserts like (*assert.Assertions).NoFDLeak and stops the test on failure.
+func NoFDLeak(t assert.TestingT, f func(), msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoFDLeak(f, msgAndArgs...)
+}
+
+// NoFDLeakf asserts like (*assert.Assertions).NoFDLeakf and stops the test on failure.
+func NoFDLeakf(t assert.TestingT, f func(), msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoFDLeakf(f, msg, args...)
+}
+
+// NoFileExists asserts like (*assert.Assertions).NoFileExists and stops the test on failure.
+func NoFileExists(t assert.TestingT, path string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoFileExists(path, msgAndArgs...)
+}
+
+// NoFileExistsf asserts like (*assert.Assertions).NoFileExistsf and stops the test on failure.
+func NoFileExistsf(t assert.TestingT, path string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoFileExistsf(path, msg, args...)
+}
+
+// NoRaceUnderStress asserts like (*assert.Assertions).NoRaceUnderStress and stops the test on failure.
+func NoRaceUnderStress(t assert.TestingT, iterations int, fns ...func()) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoRaceUnderStress(iterations, fns...)
+}
+
+// NoneMatch asserts like (*assert.Assertions).NoneMatch and stops the test on failure.
+func NoneMatch(t assert.TestingT, list any, predicate func(el any) bool, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoneMatch(list, predicate, msgAndArgs...)
+}
+
+// NoneMatchf asserts like (*assert.Assertions).NoneMatchf and stops the test on failure.
+func NoneMatchf(t assert.TestingT, list any, predicate func(el any) bool, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NoneMatchf(list, predicate, msg, args...)
+}
+
+// NotBlank asserts like (*assert.Assertions).NotBlank and stops the test on failure.
+func NotBlank(t assert.TestingT, s string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotBlank(s, msgAndArgs...)
+}
+
+// NotBlankf asserts like (*assert.Assertions).NotBlankf and stops the test on failure.
+func NotBlankf(t assert.TestingT, s string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotBlankf(s, msg, args...)
+}
+
+// NotContains asserts like (*assert.Assertions).NotContains and stops the test on failure.
+func NotContains(t assert.TestingT, s, contains any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotContains(s, contains, msgAndArgs...)
+}
+
+// NotContainsf asserts like (*assert.Assertions).NotContainsf and stops the test on failure.
+func NotContainsf(t assert.TestingT, s, contains any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotContainsf(s, contains, msg, args...)
+}
+
+// NotEmpty asserts like (*assert.Assertions).NotEmpty and stops the test on failure.
+func NotEmpty(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotEmpty(object, msgAndArgs...)
+}
+
+// NotEmptyf asserts like (*assert.Assertions).NotEmptyf and stops the test on failure.
+func NotEmptyf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotEmptyf(object, msg, args...)
+}
+
+// NotEqual asserts like (*assert.Assertions).NotEqual and stops the test on failure.
+func NotEqual(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotEqual(expected, actual, msgAndArgs...)
+}
+
+// NotEqualValues asserts like (*assert.Assertions).NotEqualValues and stops the test on failure.
+func NotEqualValues(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotEqualValues(expected, actual, msgAndArgs...)
+}
+
+// NotEqualValuesf asserts like (*assert.Assertions).NotEqualValuesf and stops the test on failure.
+func NotEqualValuesf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotEqualValuesf(expected, actual, msg, args...)
+}
+
+// NotEqualf asserts like (*assert.Assertions).NotEqualf and stops the test on failure.
+func NotEqualf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotEqualf(expected, actual, msg, args...)
+}
+
+// NotErrorIs asserts like (*assert.Assertions).NotErrorIs and stops the test on failure.
+func NotErrorIs(t assert.TestingT, err, target error, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotErrorIs(err, target, msgAndArgs...)
+}
+
+// NotErrorIsf asserts like (*assert.Assertions).NotErrorIsf and stops the test on failure.
+func NotErrorIsf(t assert.TestingT, err, target error, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotErrorIsf(err, target, msg, args...)
+}
+
+// NotImplements asserts like (*assert.Assertions).NotImplements and stops the test on failure.
+func NotImplements(t assert.TestingT, interfaceObject any, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotImplements(interfaceObject, object, msgAndArgs...)
+}
+
+// NotImplementsf asserts like (*assert.Assertions).NotImplementsf and stops the test on failure.
+func NotImplementsf(t assert.TestingT, interfaceObject any, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotImplementsf(interfaceObject, object, msg, args...)
+}
+
+// NotLen asserts like (*assert.Assertions).NotLen and stops the test on failure.
+func NotLen(t assert.TestingT, object any, length int, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotLen(object, length, msgAndArgs...)
+}
+
+// NotLenf asserts like (*assert.Assertions).NotLenf and stops the test on failure.
+func NotLenf(t assert.TestingT, object any, length int, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotLenf(object, length, msg, args...)
+}
+
+// NotNil asserts like (*assert.Assertions).NotNil and stops the test on failure.
+func NotNil(t assert.TestingT, object any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotNil(object, msgAndArgs...)
+}
+
+// NotNilf asserts like (*assert.Assertions).NotNilf and stops the test on failure.
+func NotNilf(t assert.TestingT, object any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotNilf(object, msg, args...)
+}
+
+// NotPanics asserts like (*assert.Assertions).NotPanics and stops the test on failure.
+func NotPanics(t assert.TestingT, f assert.PanicTestFunc, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotPanics(f, msgAndArgs...)
+}
+
+// NotPanicsf asserts like (*assert.Assertions).NotPanicsf and stops the test on failure.
+func NotPanicsf(t assert.TestingT, f assert.PanicTestFunc, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotPanicsf(f, msg, args...)
+}
+
+// NotRegexp asserts like (*assert.Assertions).NotRegexp and stops the test on failure.
+func NotRegexp(t assert.TestingT, rx any, str any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotRegexp(rx, str, msgAndArgs...)
+}
+
+// NotRegexpf asserts like (*assert.Assertions).NotRegexpf and stops the test on failure.
+func NotRegexpf(t assert.TestingT, rx any, str any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotRegexpf(rx, str, msg, args...)
+}
+
+// NotSame asserts like (*assert.Assertions).NotSame and stops the test on failure.
+func NotSame(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotSame(expected, actual, msgAndArgs...)
+}
+
+// NotSamef asserts like (*assert.Assertions).NotSamef and stops the test on failure.
+func NotSamef(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotSamef(expected, actual, msg, args...)
+}
+
+// NotSubset asserts like (*assert.Assertions).NotSubset and stops the test on failure.
+func NotSubset(t assert.TestingT, list, subset any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotSubset(list, subset, msgAndArgs...)
+}
+
+// NotSubsetf asserts like (*assert.Assertions).NotSubsetf and stops the test on failure.
+func NotSubsetf(t assert.TestingT, list, subset any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotSubsetf(list, subset, msg, args...)
+}
+
+// NotZero asserts like (*assert.Assertions).NotZero and stops the test on failure.
+func NotZero(t assert.TestingT, i any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotZero(i, msgAndArgs...)
+}
+
+// NotZerof asserts like (*assert.Assertions).NotZerof and stops the test on failure.
+func NotZerof(t assert.TestingT, i any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).NotZerof(i, msg, args...)
+}
+
+// Panics asserts like (*assert.Assertions).Panics and stops the test on failure.
+func Panics(t assert.TestingT, f assert.PanicTestFunc, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Panics(f, msgAndArgs...)
+}
+
+// PanicsWithError asserts like (*assert.Assertions).PanicsWithError and stops the test on failure.
+func PanicsWithError(t assert.TestingT, errString string, f assert.PanicTestFunc, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PanicsWithError(errString, f, msgAndArgs...)
+}
+
+// PanicsWithErrorf asserts like (*assert.Assertions).PanicsWithErrorf and stops the test on failure.
+func PanicsWithErrorf(t assert.TestingT, errString string, f assert.PanicTestFunc, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PanicsWithErrorf(errString, f, msg, args...)
+}
+
+// PanicsWithValue asserts like (*assert.Assertions).PanicsWithValue and stops the test on failure.
+func PanicsWithValue(t assert.TestingT, expected any, f assert.PanicTestFunc, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PanicsWithValue(expected, f, msgAndArgs...)
+}
+
+// PanicsWithValuef asserts like (*assert.Assertions).PanicsWithValuef and stops the test on failure.
+func PanicsWithValuef(t assert.TestingT, expected any, f assert.PanicTestFunc, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PanicsWithValuef(expected, f, msg, args...)
+}
+
+// Panicsf asserts like (*assert.Assertions).Panicsf and stops the test on failure.
+func Panicsf(t assert.TestingT, f assert.PanicTestFunc, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Panicsf(f, msg, args...)
+}
+
+// PercentileLE asserts like (*assert.Assertions).PercentileLE and stops the test on failure.
+func PercentileLE(t assert.TestingT, samples any, p, threshold float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PercentileLE(samples, p, threshold, msgAndArgs...)
+}
+
+// PercentileLEf asserts like (*assert.Assertions).PercentileLEf and stops the test on failure.
+func PercentileLEf(t assert.TestingT, samples any, p, threshold float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PercentileLEf(samples, p, threshold, msg, args...)
+}
+
+// Positive asserts like (*assert.Assertions).Positive and stops the test on failure.
+func Positive(t assert.TestingT, e any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Positive(e, msgAndArgs...)
+}
+
+// Positivef asserts like (*assert.Assertions).Positivef and stops the test on failure.
+func Positivef(t assert.TestingT, e any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Positivef(e, msg, args...)
+}
+
+// PrintsToStderr asserts like (*assert.Assertions).PrintsToStderr and stops the test on failure.
+func PrintsToStderr(t assert.TestingT, f func(), expected string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PrintsToStderr(f, expected, msgAndArgs...)
+}
+
+// PrintsToStderrf asserts like (*assert.Assertions).PrintsToStderrf and stops the test on failure.
+func PrintsToStderrf(t assert.TestingT, f func(), expected string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PrintsToStderrf(f, expected, msg, args...)
+}
+
+// PrintsToStdout asserts like (*assert.Assertions).PrintsToStdout and stops the test on failure.
+func PrintsToStdout(t assert.TestingT, f func(), expected string, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PrintsToStdout(f, expected, msgAndArgs...)
+}
+
+// PrintsToStdoutf asserts like (*assert.Assertions).PrintsToStdoutf and stops the test on failure.
+func PrintsToStdoutf(t assert.TestingT, f func(), expected string, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).PrintsToStdoutf(f, expected, msg, args...)
+}
+
+// Regexp asserts like (*assert.Assertions).Regexp and stops the test on failure.
+func Regexp(t assert.TestingT, rx any, str any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Regexp(rx, str, msgAndArgs...)
+}
+
+// Regexpf asserts like (*assert.Assertions).Regexpf and stops the test on failure.
+func Regexpf(t assert.TestingT, rx any, str any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Regexpf(rx, str, msg, args...)
+}
+
+// RoundTrips asserts like (*assert.Assertions).RoundTrips and stops the test on failure.
+func RoundTrips(t assert.TestingT, value any, marshal assert.MarshalFunc, unmarshal assert.UnmarshalFunc, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).RoundTrips(value, marshal, unmarshal, msgAndArgs...)
+}
+
+// RoundTripsf asserts like (*assert.Assertions).RoundTripsf and stops the test on failure.
+func RoundTripsf(t assert.TestingT, value any, marshal assert.MarshalFunc, unmarshal assert.UnmarshalFunc, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).RoundTripsf(value, marshal, unmarshal, msg, args...)
+}
+
+// Same asserts like (*assert.Assertions).Same and stops the test on failure.
+func Same(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Same(expected, actual, msgAndArgs...)
+}
+
+// Samef asserts like (*assert.Assertions).Samef and stops the test on failure.
+func Samef(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Samef(expected, actual, msg, args...)
+}
+
+// StdDevLE asserts like (*assert.Assertions).StdDevLE and stops the test on failure.
+func StdDevLE(t assert.TestingT, samples any, threshold float64, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).StdDevLE(samples, threshold, msgAndArgs...)
+}
+
+// StdDevLEf asserts like (*assert.Assertions).StdDevLEf and stops the test on failure.
+func StdDevLEf(t assert.TestingT, samples any, threshold float64, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).StdDevLEf(samples, threshold, msg, args...)
+}
+
+// Subset asserts like (*assert.Assertions).Subset and stops the test on failure.
+func Subset(t assert.TestingT, list, subset any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Subset(list, subset, msgAndArgs...)
+}
+
+// Subsetf asserts like (*assert.Assertions).Subsetf and stops the test on failure.
+func Subsetf(t assert.TestingT, list, subset any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Subsetf(list, subset, msg, args...)
+}
+
+// True asserts like (*assert.Assertions).True and stops the test on failure.
+func True(t assert.TestingT, value bool, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).True(value, msgAndArgs...)
+}
+
+// Truef asserts like (*assert.Assertions).Truef and stops the test on failure.
+func Truef(t assert.TestingT, value bool, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Truef(value, msg, args...)
+}
+
+// WaitGroupDoneWithin asserts like (*assert.Assertions).WaitGroupDoneWithin and stops the test on failure.
+func WaitGroupDoneWithin(t assert.TestingT, wg *sync.WaitGroup, timeout time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).WaitGroupDoneWithin(wg, timeout, msgAndArgs...)
+}
+
+// WaitGroupDoneWithinf asserts like (*assert.Assertions).WaitGroupDoneWithinf and stops the test on failure.
+func WaitGroupDoneWithinf(t assert.TestingT, wg *sync.WaitGroup, timeout time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).WaitGroupDoneWithinf(wg, timeout, msg, args...)
+}
+
+// WithinDuration asserts like (*assert.Assertions).WithinDuration and stops the test on failure.
+func WithinDuration(t assert.TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).WithinDuration(expected, actual, delta, msgAndArgs...)
+}
+
+// WithinDurationf asserts like (*assert.Assertions).WithinDurationf and stops the test on failure.
+func WithinDurationf(t assert.TestingT, expected, actual time.Time, delta time.Duration, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).WithinDurationf(expected, actual, delta, msg, args...)
+}
+
+// WithinTimeRange asserts like (*assert.Assertions).WithinTimeRange and stops the test on failure.
+func WithinTimeRange(t assert.TestingT, actual, start, end time.Time, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).WithinTimeRange(actual, start, end, msgAndArgs...)
+}
+
+// WithinTimeRangef asserts like (*assert.Assertions).WithinTimeRangef and stops the test on failure.
+func WithinTimeRangef(t assert.TestingT, actual, start, end time.Time, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).WithinTimeRangef(actual, start, end, msg, args...)
+}
+
+// YAMLEq asserts like (*assert.Assertions).YAMLEq and stops the test on failure.
+func YAMLEq(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).YAMLEq(expected, actual, msgAndArgs...)
+}
+
+// YAMLEqf asserts like (*assert.Assertions).YAMLEqf and stops the test on failure.
+func YAMLEqf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).YAMLEqf(expected, actual, msg, args...)
+}
+
+// Zero asserts like (*assert.Assertions).Zero and stops the test on failure.
+func Zero(t assert.TestingT, i any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Zero(i, msgAndArgs...)
+}
+
+// Zerof asserts like (*assert.Assertions).Zerof and stops the test on failure.
+func Zerof(t assert.TestingT, i any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).Zerof(i, msg, args...)
+}
+
+// ZipEqual asserts like (*assert.Assertions).ZipEqual and stops the test on failure.
+func ZipEqual(t assert.TestingT, expected, actual any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ZipEqual(expected, actual, msgAndArgs...)
+}
+
+// ZipEqualf asserts like (*assert.Assertions).ZipEqualf and stops the test on failure.
+func ZipEqualf(t assert.TestingT, expected, actual any, msg string, args ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.New(t).ZipEqualf(expected, actual, msg, args...)
+}
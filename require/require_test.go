@@ -0,0 +1,101 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package require
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/tisonkun/assert"
+)
+
+// fatalT records failures and stops the calling goroutine on FailNow, like
+// *testing.T does.
+type fatalT struct {
+	buf    bytes.Buffer
+	failed bool
+}
+
+func (t *fatalT) Errorf(format string, args ...any) {
+	fmt.Fprintf(&t.buf, format, args...)
+}
+
+func (t *fatalT) FailNow() {
+	t.failed = true
+	runtime.Goexit()
+}
+
+// run runs f with a fresh fatalT and reports whether f returned normally.
+func run(f func(t *fatalT)) (*fatalT, bool) {
+	t := new(fatalT)
+	returned := false
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		f(t)
+		returned = true
+	}()
+	wg.Wait()
+	return t, returned
+}
+
+func TestRequire(t *testing.T) {
+	mock, returned := run(func(t *fatalT) {
+		Equal(t, 1, 1)
+		NoError(t, nil)
+		Len(t, []int{1, 2}, 2)
+	})
+	assert.New(t).True(returned)
+	assert.New(t).False(mock.failed)
+
+	mock, returned = run(func(t *fatalT) {
+		Equal(t, 1, 2, "answer")
+	})
+	assert.New(t).False(returned)
+	assert.New(t).True(mock.failed)
+	assert.New(t).Contains(mock.buf.String(), "Not equal")
+	assert.New(t).Contains(mock.buf.String(), "answer")
+}
+
+func TestRequireCounted(t *testing.T) {
+	assert.ExpectAssertions(t, 3)
+	Equal(t, 1, 1)
+	Nil(t, nil)
+	assert.New(t).Equal(2, assert.AssertionCount(t))
+}
+
+func TestRequireCoversAssertions(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "require.go", nil, 0)
+	assert.New(t).NoError(err)
+	generated := map[string]bool{}
+	for name := range f.Scope.Objects {
+		generated[name] = true
+	}
+
+	typ := reflect.TypeOf((*assert.Assertions)(nil))
+	for i := 0; i < typ.NumMethod(); i++ {
+		m := typ.Method(i)
+		if m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Bool {
+			assert.New(t).True(generated[m.Name], "require.%s is missing, run go generate", m.Name)
+		}
+	}
+}
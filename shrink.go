@@ -0,0 +1,147 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"reflect"
+)
+
+// Shrinker returns simpler candidates for a failing property input, the
+// most aggressive first. Candidates must have the same type as v. Returning
+// no candidates means v cannot be shrunk any further.
+type Shrinker func(v any) []any
+
+// DefaultShrinker shrinks integers, strings and slices with ShrinkInt,
+// ShrinkString and ShrinkSlice, and leaves other values as they are.
+func DefaultShrinker(v any) []any {
+	if v == nil {
+		return nil
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return ShrinkInt(v)
+	case reflect.String:
+		return ShrinkString(v)
+	case reflect.Slice:
+		return ShrinkSlice(v)
+	}
+	return nil
+}
+
+// ShrinkInt shrinks an integer of any kind towards zero: it proposes zero,
+// half the value and the value one step closer to zero.
+func ShrinkInt(v any) []any {
+	value := reflect.ValueOf(v)
+	var candidates []int64
+	var ucandidates []uint64
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := value.Int()
+		if n == 0 {
+			return nil
+		}
+		step := int64(1)
+		if n < 0 {
+			step = -1
+		}
+		candidates = []int64{0, n / 2, n - step}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := value.Uint()
+		if n == 0 {
+			return nil
+		}
+		ucandidates = []uint64{0, n / 2, n - 1}
+	default:
+		return nil
+	}
+
+	var shrunk []any
+	seen := map[any]bool{v: true}
+	add := func(c reflect.Value) {
+		if candidate := c.Convert(value.Type()).Interface(); !seen[candidate] {
+			seen[candidate] = true
+			shrunk = append(shrunk, candidate)
+		}
+	}
+	for _, c := range candidates {
+		add(reflect.ValueOf(c))
+	}
+	for _, c := range ucandidates {
+		add(reflect.ValueOf(c))
+	}
+	return shrunk
+}
+
+// ShrinkString shrinks a string by proposing the empty string, each half,
+// and the string with a single rune removed.
+func ShrinkString(v any) []any {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.String || value.Len() == 0 {
+		return nil
+	}
+	runes := []rune(value.String())
+
+	var shrunk []any
+	seen := map[string]bool{value.String(): true}
+	add := func(s string) {
+		if !seen[s] {
+			seen[s] = true
+			shrunk = append(shrunk, reflect.ValueOf(s).Convert(value.Type()).Interface())
+		}
+	}
+	add("")
+	add(string(runes[:len(runes)/2]))
+	add(string(runes[len(runes)/2:]))
+	for i := range runes {
+		add(string(runes[:i]) + string(runes[i+1:]))
+	}
+	return shrunk
+}
+
+// ShrinkSlice shrinks a slice by proposing the empty slice, each half, the
+// slice with a single element removed, and the slice with a single element
+// shrunk by DefaultShrinker.
+func ShrinkSlice(v any) []any {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice || value.Len() == 0 {
+		return nil
+	}
+	n := value.Len()
+
+	concat := func(parts ...reflect.Value) any {
+		s := reflect.MakeSlice(value.Type(), 0, n)
+		for _, part := range parts {
+			s = reflect.AppendSlice(s, part)
+		}
+		return s.Interface()
+	}
+
+	shrunk := []any{concat()}
+	if n > 1 {
+		shrunk = append(shrunk, concat(value.Slice(0, n/2)), concat(value.Slice(n/2, n)))
+	}
+	for i := 0; i < n; i++ {
+		shrunk = append(shrunk, concat(value.Slice(0, i), value.Slice(i+1, n)))
+	}
+	for i := 0; i < n; i++ {
+		for _, candidate := range DefaultShrinker(value.Index(i).Interface()) {
+			s := reflect.ValueOf(concat(value))
+			s.Index(i).Set(reflect.ValueOf(candidate))
+			shrunk = append(shrunk, s.Interface())
+		}
+	}
+	return shrunk
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"testing"
+)
+
+type celsius int
+
+func TestShrinkInt(t *testing.T) {
+	New(t).Equal([]any{0, 50, 99}, ShrinkInt(100))
+	New(t).Equal([]any{int8(0), int8(-50), int8(-99)}, ShrinkInt(int8(-100)))
+	New(t).Equal([]any{uint(0), uint(1)}, ShrinkInt(uint(2)))
+	New(t).Equal([]any{celsius(0)}, ShrinkInt(celsius(1)))
+	New(t).Empty(ShrinkInt(0))
+	New(t).Empty(ShrinkInt("1"))
+}
+
+func TestShrinkString(t *testing.T) {
+	New(t).Equal([]any{"", "a", "bc", "ac", "ab"}, ShrinkString("abc"))
+	New(t).Equal([]any{"", "é"}, ShrinkString("éé"))
+	New(t).Empty(ShrinkString(""))
+	New(t).Empty(ShrinkString(1))
+}
+
+func TestShrinkSlice(t *testing.T) {
+	New(t).Equal([]any{
+		[]int{},
+		[]int{3},
+		[]int{0},
+		[]int{0},
+		[]int{3},
+		[]int{0, 0},
+		[]int{1, 0},
+		[]int{2, 0},
+	}, ShrinkSlice([]int{3, 0}))
+
+	New(t).Equal([]any{[]string{}, []string{}, []string{""}, []string{"a"}, []string{"b"}}, ShrinkSlice([]string{"ab"}))
+	New(t).Empty(ShrinkSlice([]string{}))
+	New(t).Empty(ShrinkSlice("abc"))
+}
+
+func TestDefaultShrinker(t *testing.T) {
+	New(t).Equal(ShrinkInt(42), DefaultShrinker(42))
+	New(t).Equal(ShrinkString("ab"), DefaultShrinker("ab"))
+	New(t).Equal(ShrinkSlice([]bool{true}), DefaultShrinker([]bool{true}))
+	New(t).Empty(DefaultShrinker(1.5))
+	New(t).Empty(DefaultShrinker(nil))
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import "sync"
+
+// FailureEvent is the structured failure delivered to sinks.
+type FailureEvent = Failure
+
+type sinkEntry struct {
+	sink func(FailureEvent)
+}
+
+var sinks struct {
+	mu      sync.RWMutex
+	entries []*sinkEntry
+}
+
+// RegisterSink subscribes sink to every assertion failure in the process,
+// independent of the text written to the test log. Sinks are called
+// synchronously from the failing goroutine, in registration order, and
+// must be safe for concurrent use. Failures of Quiet Assertions are not
+// delivered, nor are those of the checks nested in assertions such as
+// ForAll and All, which deliver their own failure instead.
+//
+// The returned function unregisters the sink.
+//
+//	unregister := assert.RegisterSink(func(e assert.FailureEvent) {
+//		tracker.Record(e.Test, e.Assertion)
+//	})
+//	defer unregister()
+func RegisterSink(sink func(FailureEvent)) (unregister func()) {
+	entry := &sinkEntry{sink}
+	sinks.mu.Lock()
+	defer sinks.mu.Unlock()
+	sinks.entries = append(sinks.entries, entry)
+	return func() {
+		sinks.mu.Lock()
+		defer sinks.mu.Unlock()
+		for i, e := range sinks.entries {
+			if e == entry {
+				sinks.entries = append(sinks.entries[:i:i], sinks.entries[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// emitFailure delivers a failure to the registered sinks, unless the
+// Assertions is nested.
+func (a *Assertions) emitFailure(failure Failure) {
+	if a.nested {
+		return
+	}
+	sinks.mu.RLock()
+	entries := sinks.entries
+	sinks.mu.RUnlock()
+
+	for _, e := range entries {
+		e.sink(failure)
+	}
+}
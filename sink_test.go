@@ -0,0 +1,72 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRegisterSink(t *testing.T) {
+	var events []FailureEvent
+	unregister := RegisterSink(func(e FailureEvent) {
+		events = append(events, e)
+	})
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out)
+	a.Equal(1, 2, "numbers %d", 1)
+	a.True(true)
+	a.Quiet().Equal(1, 2)
+	a.withLabel("Case", "#0 first").Nil(42)
+
+	New(t).Len(events, 2)
+	New(t).Equal("Equal", events[0].Assertion)
+	New(t).Contains(events[0].Message, "Not equal")
+	New(t).Equal("numbers 1", events[0].UserMessage)
+	New(t).False(events[0].Time.IsZero())
+	New(t).Nil(events[0].Labels)
+	New(t).Equal("Nil", events[1].Assertion)
+	New(t).Equal(map[string]string{"Case": "#0 first"}, events[1].Labels)
+	New(t).Contains(out.buf.String(), "Not equal")
+
+	unregister()
+	a.Equal(1, 2)
+	New(t).Len(events, 2)
+}
+
+func TestNestedFailuresAreNotEmitted(t *testing.T) {
+	var events []Failure
+	unregister := RegisterSink(func(e Failure) {
+		events = append(events, e)
+	})
+	defer unregister()
+
+	hooked := 0
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).AddOnFailure(func(TestingT, Failure) bool {
+		hooked++
+		return true
+	})
+	New(t).False(a.EveryElement([]int{1, 2, 3}, func(a *Assertions, el any) {
+		a.Equal(0, el)
+	}))
+
+	New(t).Len(events, 1)
+	New(t).Equal("EveryElement", events[0].Assertion)
+	New(t).Equal(1, hooked)
+}
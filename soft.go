@@ -0,0 +1,71 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import "time"
+
+// SoftAssertions records failures of its assertions without failing the
+// test, and reports all of them together with AssertAll. Table-driven tests
+// use it to see every mismatch instead of only the first.
+//
+// With a TestingT that has a Cleanup method, e.g. *testing.T, failures not
+// reported by AssertAll are reported at the end of the test.
+//
+//	soft := assert.New(t).Soft()
+//	for _, c := range cases {
+//		soft.Equal(c.want, Compute(c.input), "input %v", c.input)
+//	}
+//	soft.AssertAll()
+type SoftAssertions struct {
+	*Assertions
+}
+
+// Soft returns SoftAssertions that record the failures of assertions made
+// through them.
+func (a *Assertions) Soft() *SoftAssertions {
+	d := &deferredFailures{}
+	if c, ok := a.t.(cleaner); ok {
+		c.Cleanup(func() {
+			if report := d.take(); report != "" {
+				a.t.Errorf("\n%s", report)
+			}
+		})
+	}
+	cp := *a
+	cp.deferred = d
+	return &SoftAssertions{&cp}
+}
+
+// AssertAll reports the failures recorded since the last call, if any, and
+// then fails like the Assertions Soft was called on, which stops the test
+// by default. It returns whether no failure was recorded.
+func (s *SoftAssertions) AssertAll() bool {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	report := s.deferred.take()
+	if report == "" {
+		return true
+	}
+	s.t.Errorf("\n%s", report)
+	if s.onFailureDetail != nil {
+		s.onFailureDetail(s.t, Failure{Assertion: "AssertAll", Message: report, Time: time.Now()})
+		return false
+	}
+	if s.onFailure != nil {
+		s.onFailure(s.t)
+	}
+	return false
+}
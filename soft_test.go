@@ -0,0 +1,63 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSoftAssertions(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	failures := 0
+	soft := New(out).WithOnFailure(func(TestingT) { failures++ }).Soft()
+
+	New(t).True(soft.AssertAll())
+	New(t).False(soft.Equal(1, 2))
+	New(t).False(soft.Equal("a", "b"))
+	New(t).False(soft.Len([]int{1}, 2))
+	New(t).Equal(0, out.buf.Len())
+	New(t).Equal(0, failures)
+
+	New(t).False(soft.AssertAll())
+	New(t).Equal(1, failures)
+	New(t).Contains(out.buf.String(), "3 deferred failure(s) in 2 group(s):")
+	New(t).Contains(out.buf.String(), "Equal: 2 failure(s)")
+	New(t).Contains(out.buf.String(), "Len: 1 failure(s)")
+
+	out.buf.Reset()
+	New(t).True(soft.AssertAll())
+	New(t).False(soft.True(false))
+	out.runCleanups()
+	New(t).Equal(1, failures)
+	New(t).Equal(1, strings.Count(out.buf.String(), "1 deferred failure(s) in 1 group(s):"))
+
+	out.buf.Reset()
+	out.runCleanups()
+	New(t).Equal(0, out.buf.Len())
+}
+
+func TestSoftAssertionsWithoutCleanup(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	soft := NewWithOnFailureNoop(out).Soft()
+
+	New(t).False(soft.NotNil(nil))
+	New(t).Equal(0, out.buf.Len())
+	New(t).False(soft.AssertAll())
+	New(t).Contains(out.buf.String(), "NotNil: 1 failure(s)")
+}
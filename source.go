@@ -0,0 +1,85 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"bufio"
+	"os"
+	"runtime"
+	"strings"
+)
+
+// callerFrame returns the frame of the outermost caller outside of the
+// non-test sources of this module's packages, which is where the assertion
+// was made.
+func callerFrame() (runtime.Frame, bool) {
+	pc := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+	for {
+		frame, more := frames.Next()
+		if !internalFrame(frame) {
+			return frame, frame.File != ""
+		}
+		if !more {
+			return runtime.Frame{}, false
+		}
+	}
+}
+
+// WithSourceLine returns a new Assertions that includes the source line of
+// the failed assertion call in failure messages, so that logs show the
+// asserted expression alongside the values:
+//
+//	Source: 	a.Equal(want, got.Status)
+//
+// The line is read from the source file when the failure is reported, so it
+// is omitted if the sources are not available.
+func (a *Assertions) WithSourceLine() *Assertions {
+	c := *a
+	c.sourceLine = true
+	return &c
+}
+
+// sourceContent returns the source line of the failed assertion call as a
+// labeled content, if it can be read.
+func sourceContent() (labeledContent, bool) {
+	frame, ok := callerFrame()
+	if !ok {
+		return labeledContent{}, false
+	}
+	line, ok := readSourceLine(frame.File, frame.Line)
+	if !ok {
+		return labeledContent{}, false
+	}
+	return labeledContent{"Source", line}, true
+}
+
+// readSourceLine returns the given line of a file, with surrounding
+// whitespace trimmed.
+func readSourceLine(file string, line int) (string, bool) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		if n == line {
+			return strings.TrimSpace(scanner.Text()), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+)
+
+func TestWithSourceLine(t *testing.T) {
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).WithSourceLine()
+	a.Equal("want", "got", "status") // the asserted line
+	New(t).Contains(out.buf.String(), "Source:")
+	New(t).Contains(out.buf.String(), `a.Equal("want", "got", "status") // the asserted line`)
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	NewWithOnFailureNoop(out).Equal("want", "got")
+	New(t).NotContains(out.buf.String(), "Source:")
+}
+
+func TestReadSourceLine(t *testing.T) {
+	_, file, line, _ := runtime.Caller(0)
+	source, ok := readSourceLine(file, line)
+	New(t).True(ok)
+	New(t).Equal("_, file, line, _ := runtime.Caller(0)", source)
+
+	_, ok = readSourceLine(file, 1<<20)
+	New(t).False(ok)
+	_, ok = readSourceLine(file+".missing", 1)
+	New(t).False(ok)
+}
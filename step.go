@@ -0,0 +1,36 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import "strings"
+
+// Step returns a new Assertions whose failures are labeled with the named
+// step. Steps nest: the failures of a.Step("checkout").Step("pay") carry
+// the breadcrumb "checkout > pay". The step is also part of the Failure and
+// of the groups in the Deferred report.
+//
+//	user, err := CreateUser(ctx)
+//	a.Step("create user").NoError(err)
+func (a *Assertions) Step(name string) *Assertions {
+	c := *a
+	c.steps = append(append([]string(nil), a.steps...), name)
+	return &c
+}
+
+// stepBreadcrumb returns the breadcrumb of the current steps, or an empty
+// string outside of any step.
+func (a *Assertions) stepBreadcrumb() string {
+	return strings.Join(a.steps, " > ")
+}
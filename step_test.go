@@ -0,0 +1,59 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestStep(t *testing.T) {
+	var failures []Failure
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).AddOnFailure(func(_ TestingT, f Failure) bool {
+		failures = append(failures, f)
+		return true
+	})
+
+	checkout := a.Step("checkout")
+	New(t).False(checkout.Step("pay").NoError(errors.New("declined")))
+	New(t).Contains(out.buf.String(), "Step:")
+	New(t).Contains(out.buf.String(), "checkout > pay")
+
+	New(t).False(checkout.True(false))
+	New(t).False(a.True(false))
+
+	New(t).Len(failures, 3)
+	New(t).Equal("checkout > pay", failures[0].Step)
+	New(t).Equal("checkout", failures[1].Step)
+	New(t).Equal("", failures[2].Step)
+	New(t).Equal([]string{"Error Trace", "Error"}, contentLabels(failures[2]))
+}
+
+func TestStepDeferred(t *testing.T) {
+	out := &cleanupT{outputT: outputT{buf: bytes.NewBuffer(nil)}}
+	a := New(out).Deferred()
+	a.Step("create user").True(false)
+	a.Step("create user").True(false)
+	a.True(false)
+	out.runCleanups()
+
+	New(t).Contains(out.buf.String(), "3 deferred failure(s) in 2 group(s):")
+	New(t).Contains(out.buf.String(), "create user > True: 2 failure(s)")
+	New(t).Contains(out.buf.String(), "\nTrue: 1 failure(s)")
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suite
+
+import (
+	"testing"
+	"time"
+)
+
+// TestingSuite can store and return the current *testing.T context
+// generated by 'go test'.
+type TestingSuite interface {
+	T() *testing.T
+	SetT(t *testing.T)
+}
+
+// SetupAllSuite has a SetupSuite method, which will run before the tests in
+// the suite are run.
+type SetupAllSuite interface {
+	SetupSuite()
+}
+
+// SetupTestSuite has a SetupTest method, which will run before each test in
+// the suite.
+type SetupTestSuite interface {
+	SetupTest()
+}
+
+// TearDownAllSuite has a TearDownSuite method, which will run after all the
+// tests in the suite have been run.
+type TearDownAllSuite interface {
+	TearDownSuite()
+}
+
+// TearDownTestSuite has a TearDownTest method, which will run after each
+// test in the suite.
+type TearDownTestSuite interface {
+	TearDownTest()
+}
+
+// OrderedSuite has a TestOrder method returning the names of test methods
+// that must run sequentially in the given order. They run before all the
+// other tests of the suite.
+type OrderedSuite interface {
+	TestOrder() []string
+}
+
+// ParallelSuite has a ParallelTests method returning the names of test
+// methods that may run in parallel with each other. Each of them runs on a
+// shallow copy of the suite, so that it has its own T and Assertions.
+type ParallelSuite interface {
+	ParallelTests() []string
+}
+
+// TimeoutSuite has a TestTimeouts method returning the maximum duration of
+// test methods by name. A test that exceeds its timeout is reported as timed
+// out right away, but the goroutine running it cannot be stopped: the test
+// fails once the method and its teardown return, before the next test
+// starts.
+type TimeoutSuite interface {
+	TestTimeouts() map[string]time.Duration
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package suite runs groups of tests that share setup and teardown logic
+// on top of Go subtests.
+//
+// A suite is a struct embedding Suite, whose exported methods with a name
+// starting with "Test" are run as subtests:
+//
+//	type UserSuite struct {
+//		suite.Suite
+//		db *DB
+//	}
+//
+//	func (s *UserSuite) SetupTest() { s.db = OpenTestDB() }
+//
+//	func (s *UserSuite) TestCreate() {
+//		s.NoError(s.db.Create("tison"))
+//	}
+//
+//	func TestUserSuite(t *testing.T) {
+//		suite.RunSuite(t, new(UserSuite))
+//	}
+package suite
+
+import (
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tisonkun/assert"
+)
+
+// Suite is a basic testing suite with methods for storing and retrieving
+// the current *testing.T context. Its embedded Assertions always reports
+// through the test that is currently running.
+type Suite struct {
+	*assert.Assertions
+	t *testing.T
+}
+
+// T retrieves the current *testing.T context.
+func (s *Suite) T() *testing.T {
+	return s.t
+}
+
+// SetT sets the current *testing.T context.
+func (s *Suite) SetT(t *testing.T) {
+	s.t = t
+	s.Assertions = assert.New(t)
+}
+
+// RunSuite takes a testing suite and runs all of the tests attached to it.
+//
+// Tests listed by an OrderedSuite run first, in order. The remaining tests
+// run in method name order, except the ones listed by a ParallelSuite which
+// run in parallel once all the sequential tests are done.
+func RunSuite(t *testing.T, s TestingSuite) {
+	t.Helper()
+
+	s.SetT(t)
+
+	if setupAllSuite, ok := s.(SetupAllSuite); ok {
+		setupAllSuite.SetupSuite()
+	}
+	if tearDownAllSuite, ok := s.(TearDownAllSuite); ok {
+		// Cleanup runs after parallel subtests complete, unlike defer.
+		t.Cleanup(func() {
+			s.SetT(t)
+			tearDownAllSuite.TearDownSuite()
+		})
+	}
+
+	methods := testMethods(s)
+	byName := make(map[string]reflect.Method, len(methods))
+	for _, method := range methods {
+		byName[method.Name] = method
+	}
+
+	var ordered, parallel []string
+	if orderedSuite, ok := s.(OrderedSuite); ok {
+		ordered = orderedSuite.TestOrder()
+	}
+	if parallelSuite, ok := s.(ParallelSuite); ok {
+		parallel = parallelSuite.ParallelTests()
+	}
+	var timeouts map[string]time.Duration
+	if timeoutSuite, ok := s.(TimeoutSuite); ok {
+		timeouts = timeoutSuite.TestTimeouts()
+	}
+
+	for _, name := range append(append([]string(nil), ordered...), parallel...) {
+		if _, ok := byName[name]; !ok {
+			t.Errorf("suite has no test method %q", name)
+		}
+	}
+	for name := range timeouts {
+		if _, ok := byName[name]; !ok {
+			t.Errorf("suite has no test method %q", name)
+		}
+	}
+
+	scheduled := make(map[string]bool, len(methods))
+	run := func(name string, isParallel bool) {
+		method, ok := byName[name]
+		if !ok || scheduled[name] {
+			return
+		}
+		scheduled[name] = true
+		timeout := timeouts[name]
+		t.Run(name, func(t *testing.T) {
+			s := s
+			if isParallel {
+				s = cloneSuite(s)
+				t.Parallel()
+			}
+			runTest(t, s, method, timeout)
+		})
+	}
+
+	for _, name := range ordered {
+		run(name, false)
+	}
+	isParallel := make(map[string]bool, len(parallel))
+	for _, name := range parallel {
+		isParallel[name] = true
+	}
+	for _, method := range methods {
+		if !isParallel[method.Name] {
+			run(method.Name, false)
+		}
+	}
+	for _, name := range parallel {
+		run(name, true)
+	}
+}
+
+// runTest runs a single test method of the suite with the per test setup
+// and teardown around it. A positive timeout bounds the duration of the
+// whole run; a test that exceeds it still runs to its end, so that it does
+// not overlap the next test on the shared suite.
+func runTest(t *testing.T, s TestingSuite, method reflect.Method, timeout time.Duration) {
+	if timeout <= 0 {
+		runTestBody(t, s, method)
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runTestBody(t, s, method)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+		t.Errorf("test timed out after %v", timeout)
+		<-done
+		t.FailNow()
+	}
+}
+
+func runTestBody(t *testing.T, s TestingSuite, method reflect.Method) {
+	parent := s.T()
+	s.SetT(t)
+	defer s.SetT(parent)
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("test panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	if setupTestSuite, ok := s.(SetupTestSuite); ok {
+		setupTestSuite.SetupTest()
+	}
+	if tearDownTestSuite, ok := s.(TearDownTestSuite); ok {
+		defer tearDownTestSuite.TearDownTest()
+	}
+
+	method.Func.Call([]reflect.Value{reflect.ValueOf(s)})
+}
+
+// cloneSuite returns a shallow copy of the suite if it is a pointer to a
+// struct, or the suite itself otherwise.
+func cloneSuite(s TestingSuite) TestingSuite {
+	v := reflect.ValueOf(s)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return s
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	return c.Interface().(TestingSuite)
+}
+
+// testMethods returns the methods of the suite that look like tests.
+func testMethods(s TestingSuite) []reflect.Method {
+	suiteType := reflect.TypeOf(s)
+
+	var methods []reflect.Method
+	for i := 0; i < suiteType.NumMethod(); i++ {
+		method := suiteType.Method(i)
+		if !isTest(method.Name) || method.Type.NumIn() != 1 || method.Type.NumOut() != 0 {
+			continue
+		}
+		methods = append(methods, method)
+	}
+	return methods
+}
+
+// isTest tells whether name looks like a test method. It is a test if there
+// is a character after Test that is not a lower-case letter.
+func isTest(name string) bool {
+	if !strings.HasPrefix(name, "Test") {
+		return false
+	}
+	if len(name) == len("Test") {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(name[len("Test"):])
+	return !unicode.IsLower(r)
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suite
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tisonkun/assert"
+)
+
+type lifecycleSuite struct {
+	Suite
+	calls []string
+}
+
+func (s *lifecycleSuite) SetupSuite()    { s.calls = append(s.calls, "SetupSuite") }
+func (s *lifecycleSuite) TearDownSuite() { s.calls = append(s.calls, "TearDownSuite") }
+func (s *lifecycleSuite) SetupTest()     { s.calls = append(s.calls, "SetupTest") }
+func (s *lifecycleSuite) TearDownTest()  { s.calls = append(s.calls, "TearDownTest") }
+
+func (s *lifecycleSuite) TestOne() {
+	s.calls = append(s.calls, "TestOne")
+	s.Equal("TestLifecycle/suite/TestOne", s.T().Name())
+}
+
+func (s *lifecycleSuite) TestTwo() {
+	s.calls = append(s.calls, "TestTwo")
+	s.True(true)
+}
+
+func (s *lifecycleSuite) Testable() {
+	s.calls = append(s.calls, "Testable")
+}
+
+func (s *lifecycleSuite) TestWithArgs(int) {
+	s.calls = append(s.calls, "TestWithArgs")
+}
+
+func TestLifecycle(t *testing.T) {
+	s := new(lifecycleSuite)
+	t.Run("suite", func(t *testing.T) {
+		RunSuite(t, s)
+	})
+
+	assert.New(t).Equal([]string{
+		"SetupSuite",
+		"SetupTest", "TestOne", "TearDownTest",
+		"SetupTest", "TestTwo", "TearDownTest",
+		"TearDownSuite",
+	}, s.calls)
+	assert.New(t).Equal("TestLifecycle/suite", s.T().Name())
+}
+
+type failingSuite struct {
+	Suite
+	tornDown bool
+}
+
+func (s *failingSuite) TearDownTest() { s.tornDown = true }
+
+func (s *failingSuite) TestFail() {
+	s.Fail("expected failure")
+}
+
+func TestTearDownAfterFailNow(t *testing.T) {
+	s := new(failingSuite)
+	inner := new(testing.T)
+	s.SetT(inner)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runTest(inner, s, mustMethod(t, s, "TestFail"), 0)
+	}()
+	<-done
+
+	assert.New(t).True(s.tornDown)
+	assert.New(t).Same(inner, s.T())
+}
+
+func mustMethod(t *testing.T, s TestingSuite, name string) reflect.Method {
+	for _, m := range testMethods(s) {
+		if m.Name == name {
+			return m
+		}
+	}
+	t.Fatalf("no method %s", name)
+	return reflect.Method{}
+}
+
+// callLog is shared by pointer, so that calls on parallel copies of a suite
+// are recorded too.
+type callLog struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+type orderedSuite struct {
+	Suite
+	log *callLog
+}
+
+func (s *orderedSuite) TestOrder() []string     { return []string{"TestC", "TestA"} }
+func (s *orderedSuite) ParallelTests() []string { return []string{"TestP1", "TestP2"} }
+
+func (s *orderedSuite) record(name string) {
+	s.log.mu.Lock()
+	defer s.log.mu.Unlock()
+	s.log.calls = append(s.log.calls, name)
+}
+
+func (s *orderedSuite) TestA() { s.record("TestA") }
+func (s *orderedSuite) TestB() { s.record("TestB") }
+func (s *orderedSuite) TestC() { s.record("TestC") }
+
+func (s *orderedSuite) TestP1() {
+	s.record("TestP1")
+	s.Equal("TestOrderedAndParallel/suite/TestP1", s.T().Name())
+}
+
+func (s *orderedSuite) TestP2() {
+	s.record("TestP2")
+	s.Equal("TestOrderedAndParallel/suite/TestP2", s.T().Name())
+}
+
+func TestOrderedAndParallel(t *testing.T) {
+	s := &orderedSuite{log: new(callLog)}
+	t.Run("suite", func(t *testing.T) {
+		RunSuite(t, s)
+	})
+
+	assert.New(t).Equal([]string{"TestC", "TestA", "TestB"}, s.log.calls[:3])
+	assert.New(t).ElementsMatch([]string{"TestP1", "TestP2"}, s.log.calls[3:])
+}
+
+type timeoutSuite struct {
+	Suite
+	tornDown []*testing.T
+}
+
+func (s *timeoutSuite) TestTimeouts() map[string]time.Duration {
+	return map[string]time.Duration{"TestSlow": 10 * time.Millisecond}
+}
+
+func (s *timeoutSuite) TestSlow() {
+	time.Sleep(100 * time.Millisecond)
+	s.True(true)
+}
+
+func (s *timeoutSuite) TestNext() {}
+
+func (s *timeoutSuite) TearDownTest() {
+	s.tornDown = append(s.tornDown, s.T())
+}
+
+func TestTimeout(t *testing.T) {
+	s := new(timeoutSuite)
+	parent := new(testing.T)
+	s.SetT(parent)
+
+	slow, next := new(testing.T), new(testing.T)
+	for _, c := range []struct {
+		t    *testing.T
+		name string
+	}{{slow, "TestSlow"}, {next, "TestNext"}} {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			runTest(c.t, s, mustMethod(t, s, c.name), s.TestTimeouts()[c.name])
+		}()
+		<-done
+	}
+
+	assert.New(t).True(slow.Failed())
+	assert.New(t).False(next.Failed())
+	assert.New(t).Len(s.tornDown, 2)
+	assert.New(t).Same(slow, s.tornDown[0], "the timed out test is torn down before the next test starts")
+	assert.New(t).Same(next, s.tornDown[1])
+	assert.New(t).Same(parent, s.T())
+}
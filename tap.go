@@ -0,0 +1,102 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// TAP writes assertion results in the Test Anything Protocol (version 13)
+// for harnesses that consume TAP instead of go test output. Every call to
+// Run is a test point; failures are described in YAML diagnostic blocks.
+//
+//	tap := assert.NewTAP(os.Stdout)
+//	tap.Run("parses config", func(a *assert.Assertions) {
+//		cfg, err := Parse(input)
+//		a.NoError(err)
+//		a.Equal("prod", cfg.Env)
+//	})
+//	tap.Done()
+//
+// TAP also implements TestingT: a failure reported through an Assertions
+// created with New(tap) outside of Run becomes a failed test point of its
+// own. FailNow does nothing, so such an Assertions keeps going.
+type TAP struct {
+	mu     sync.Mutex
+	w      io.Writer
+	points int
+}
+
+// NewTAP returns a TAP that writes to w, starting with the version line.
+func NewTAP(w io.Writer) *TAP {
+	fmt.Fprintln(w, "TAP version 13")
+	return &TAP{w: w}
+}
+
+// Run runs f as a test point with the given description. Failures of the
+// Assertions passed to f are collected, and a failure that triggers FailNow
+// stops f. It returns whether the test point passed.
+func (t *TAP) Run(description string, f func(a *Assertions)) bool {
+	failures := runRecorded(New(t).WithPlainLayout(), f)
+	t.point(len(failures) == 0, description, strings.Join(failures, "\n"))
+	return len(failures) == 0
+}
+
+// Errorf implements TestingT by writing a failed test point.
+func (t *TAP) Errorf(format string, args ...any) {
+	msg := strings.TrimPrefix(fmt.Sprintf(format, args...), "\n")
+	t.point(false, tapDescription(msg), msg)
+}
+
+// FailNow implements TestingT and does nothing.
+func (t *TAP) FailNow() {}
+
+// Done writes the plan line with the number of test points written.
+func (t *TAP) Done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(t.w, "1..%d\n", t.points)
+}
+
+func (t *TAP) point(ok bool, description, diagnostics string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.points++
+
+	status := "ok"
+	if !ok {
+		status = "not ok"
+	}
+	fmt.Fprintf(t.w, "%s %d - %s\n", status, t.points, description)
+	if diagnostics != "" {
+		lines := strings.Split(strings.TrimRight(diagnostics, "\n"), "\n")
+		fmt.Fprintf(t.w, "  ---\n  message: |\n    %s\n  ...\n", strings.Join(lines, "\n    "))
+	}
+}
+
+// tapDescription derives the description of a failed test point from a
+// failure message: the content of its "Error" label, if any.
+func tapDescription(msg string) string {
+	for _, line := range strings.Split(msg, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "Error:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "Error:"))
+		}
+	}
+	return "assertion failed"
+}
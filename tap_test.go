@@ -0,0 +1,46 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTAP(t *testing.T) {
+	var buf bytes.Buffer
+	tap := NewTAP(&buf)
+
+	New(t).True(tap.Run("numbers are equal", func(a *Assertions) {
+		a.Equal(1, 1)
+	}))
+	New(t).False(tap.Run("strings are equal", func(a *Assertions) {
+		a.Equal("a", "b", "greeting")
+		a.Fail("not reached")
+	}))
+	New(tap).True(false)
+	tap.Done()
+
+	output := buf.String()
+	New(t).True(strings.HasPrefix(output, "TAP version 13\nok 1 - numbers are equal\nnot ok 2 - strings are equal\n  ---\n  message: |\n"))
+	New(t).Contains(output, "\n    Error: Not equal: \n")
+	New(t).Contains(output, "\n    Messages: greeting\n  ...\n")
+	New(t).NotContains(output, "not reached")
+	New(t).Contains(output, "not ok 3 - Should be true\n")
+	New(t).True(strings.HasSuffix(output, "  ...\n1..3\n"))
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ErrorTraceOption configures which stack frames appear in the Error Trace
+// of failure messages.
+type ErrorTraceOption func(*errorTraceConfig)
+
+type errorTraceConfig struct {
+	full    bool
+	exclude []string
+	// moduleRelative and trimPrefix select how file paths are rendered;
+	// by default only the base name is shown.
+	moduleRelative bool
+	trimPrefix     string
+	// omit drops the Error Trace altogether; see WithoutErrorTrace.
+	omit bool
+}
+
+// ErrorTraceFull keeps the frames above the test function, up to the
+// goroutine entry, in the Error Trace. By default the trace stops at the
+// test function.
+func ErrorTraceFull() ErrorTraceOption {
+	return func(c *errorTraceConfig) {
+		c.full = true
+	}
+}
+
+// ErrorTraceExclude drops the frames of functions in the given packages and
+// their subpackages from the Error Trace, e.g. shared test helpers.
+//
+//	a = a.WithErrorTrace(assert.ErrorTraceExclude("example.com/project/testutil"))
+func ErrorTraceExclude(packages ...string) ErrorTraceOption {
+	return func(c *errorTraceConfig) {
+		c.exclude = append(c.exclude[:len(c.exclude):len(c.exclude)], packages...)
+	}
+}
+
+// ErrorTraceModuleRelative renders the file paths in the Error Trace
+// relative to the root of the module that contains them, such as
+// "client/client_test.go:42", so that same-named files can be told apart and
+// editors can link them. Files outside of any module keep absolute paths.
+func ErrorTraceModuleRelative() ErrorTraceOption {
+	return func(c *errorTraceConfig) {
+		c.moduleRelative = true
+	}
+}
+
+// ErrorTraceTrimPrefix renders the file paths in the Error Trace in full,
+// with the given prefix trimmed, e.g. the checkout directory on CI.
+func ErrorTraceTrimPrefix(prefix string) ErrorTraceOption {
+	return func(c *errorTraceConfig) {
+		c.trimPrefix = prefix
+	}
+}
+
+// WithErrorTrace returns a new Assertions that selects the stack frames
+// shown in the Error Trace of its failure messages with opts.
+func (a *Assertions) WithErrorTrace(opts ...ErrorTraceOption) *Assertions {
+	c := *a
+	for _, opt := range opts {
+		opt(&c.errorTrace)
+	}
+	return &c
+}
+
+// internalPackages are this package and its subpackages that report
+// failures, whose frames are never part of the Error Trace.
+var internalPackages = func() map[string]bool {
+	pkg := reflect.TypeOf(Assertions{}).PkgPath()
+	return map[string]bool{
+		pkg:                   true,
+		pkg + "/require":      true,
+		pkg + "/mock":         true,
+		pkg + "/cmpassert":    true,
+		pkg + "/expvarassert": true,
+		pkg + "/promassert":   true,
+	}
+}()
+
+// internalFrame reports whether the frame belongs to the non-test sources
+// of internalPackages.
+func internalFrame(frame runtime.Frame) bool {
+	return internalPackages[funcPackage(frame.Function)] && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// excludes reports whether the frame of the named function is excluded.
+func (c errorTraceConfig) excludes(name string) bool {
+	pkg := funcPackage(name)
+	for _, e := range c.exclude {
+		if pkg == e || strings.HasPrefix(pkg, e+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// funcPackage returns the import path of the package of a function name as
+// reported by runtime.FuncForPC, e.g. "example.com/p" for
+// "example.com/p.(*T).Method".
+func funcPackage(name string) string {
+	slash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
+
+// formatPath renders the path of a source file for the Error Trace.
+func (c errorTraceConfig) formatPath(file string) string {
+	switch {
+	case c.moduleRelative:
+		if root, ok := moduleRoot(filepath.Dir(file)); ok {
+			if rel, err := filepath.Rel(root, file); err == nil {
+				return filepath.ToSlash(rel)
+			}
+		}
+		return file
+	case c.trimPrefix != "":
+		if strings.HasPrefix(file, c.trimPrefix) {
+			return strings.TrimPrefix(file[len(c.trimPrefix):], "/")
+		}
+		return file
+	}
+	return filepath.Base(file)
+}
+
+// moduleRoots caches the module root of source directories.
+var moduleRoots sync.Map
+
+// moduleRoot returns the nearest directory at or above dir that contains a
+// go.mod file.
+func moduleRoot(dir string) (string, bool) {
+	if root, ok := moduleRoots.Load(dir); ok {
+		return root.(string), root.(string) != ""
+	}
+	root := ""
+	for d := dir; ; {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			root = d
+			break
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			break
+		}
+		d = parent
+	}
+	moduleRoots.Store(dir, root)
+	return root, root != ""
+}
+
+// callerInfo returns the file and line number of each stack frame leading
+// from the current test to the assert call that failed, filtered as
+// configured.
+func callerInfo(config errorTraceConfig) []string {
+	var callers []string
+	for i := 0; ; i++ {
+		pc, file, line, ok := runtime.Caller(i)
+		if !ok {
+			// The breaks below failed to terminate the loop, and we ran off the
+			// end of the call stack.
+			break
+		}
+
+		// This is a huge edge case, but it will panic if this is the case
+		// see https://github.com/stretchr/testify/issues/180
+		if file == "<autogenerated>" {
+			break
+		}
+
+		f := runtime.FuncForPC(pc)
+		if f == nil {
+			break
+		}
+		name := f.Name()
+
+		// testing.tRunner is the standard library function that calls
+		// tests. Subtests are called directly by tRunner, without going through
+		// the Test/Benchmark/Example function that contains the t.Run calls, so
+		// with subtests we should break when we hit tRunner, without adding it
+		// to the list of callers.
+		if name == "testing.tRunner" && !config.full {
+			break
+		}
+
+		// runtime.goexit is the outermost frame of every goroutine, such as
+		// the one runRecorded runs nested assertions on.
+		if name == "runtime.goexit" && !config.full {
+			break
+		}
+
+		if !internalPackages[funcPackage(name)] && !config.excludes(name) {
+			callers = append(callers, fmt.Sprintf("%s:%d", config.formatPath(file), line))
+		}
+
+		if config.full {
+			continue
+		}
+
+		// Drop the package
+		segments := strings.Split(name, ".")
+		name = segments[len(segments)-1]
+		if isTest(name, "Test") ||
+			isTest(name, "Benchmark") ||
+			isTest(name, "Example") {
+			break
+		}
+	}
+
+	return callers
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 tison <wander4096@gmail.com>.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !assert_disabled
+
+package assert
+
+import (
+	"bytes"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFuncPackage(t *testing.T) {
+	New(t).Equal("github.com/tisonkun/assert", funcPackage("github.com/tisonkun/assert.(*Assertions).Equal"))
+	New(t).Equal("github.com/tisonkun/assert", funcPackage("github.com/tisonkun/assert.TestFuncPackage.func1"))
+	New(t).Equal("testing", funcPackage("testing.tRunner"))
+	New(t).Equal("example.com/a.b/c", funcPackage("example.com/a.b/c.Helper"))
+	New(t).Equal("main", funcPackage("main"))
+}
+
+func TestErrorTraceExclude(t *testing.T) {
+	config := errorTraceConfig{}
+	ErrorTraceExclude("example.com/testutil", "runtime")(&config)
+	New(t).True(config.excludes("example.com/testutil.Check"))
+	New(t).True(config.excludes("example.com/testutil/http.(*Client).Do"))
+	New(t).True(config.excludes("runtime.goexit"))
+	New(t).False(config.excludes("example.com/testutils.Check"))
+	New(t).False(config.excludes("testing.tRunner"))
+}
+
+func TestWithErrorTrace(t *testing.T) {
+	full := callerInfo(errorTraceConfig{full: true})
+	New(t).Greater(len(full), len(CallerInfo()))
+	New(t).True(strings.HasPrefix(full[0], "testing.go:"), "full trace goes up to the testing package")
+
+	excluded := callerInfo(errorTraceConfig{full: true, exclude: []string{"testing", "runtime"}})
+	New(t).Empty(excluded)
+
+	out := &outputT{buf: bytes.NewBuffer(nil)}
+	a := NewWithOnFailureNoop(out).WithErrorTrace(ErrorTraceFull())
+	a.Fail("failure")
+	New(t).Contains(out.buf.String(), "testing.go:")
+
+	out = &outputT{buf: bytes.NewBuffer(nil)}
+	a = NewWithOnFailureNoop(out).WithErrorTrace(ErrorTraceFull()).WithErrorTrace(ErrorTraceExclude("testing"))
+	New(t).Equal(errorTraceConfig{full: true, exclude: []string{"testing"}}, a.errorTrace)
+	a.Fail("failure")
+	New(t).Contains(out.buf.String(), "Error Trace:")
+	New(t).NotContains(out.buf.String(), "testing.go:")
+}
+
+func TestErrorTracePaths(t *testing.T) {
+	_, file, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(file)
+
+	config := errorTraceConfig{}
+	New(t).Equal("trace_test.go", config.formatPath(file))
+
+	ErrorTraceModuleRelative()(&config)
+	New(t).Equal("trace_test.go", config.formatPath(file))
+	New(t).Equal("clock/clock.go", config.formatPath(filepath.Join(dir, "clock", "clock.go")))
+	New(t).Equal("/nonexistent/client_test.go", config.formatPath("/nonexistent/client_test.go"))
+
+	config = errorTraceConfig{}
+	ErrorTraceTrimPrefix(dir)(&config)
+	New(t).Equal("clock/clock.go", config.formatPath(filepath.Join(dir, "clock", "clock.go")))
+	New(t).Equal("/other/client_test.go", config.formatPath("/other/client_test.go"))
+
+	full := callerInfo(errorTraceConfig{full: true, moduleRelative: true})
+	New(t).NotEmpty(full)
+	New(t).True(strings.HasPrefix(full[0], "testing/testing.go:"), "the testing package is relative to the std module")
+}
+
+func TestCallerInfoOnGoroutine(t *testing.T) {
+	done := make(chan []string)
+	go func() {
+		done <- callerInfo(errorTraceConfig{})
+	}()
+	for _, caller := range <-done {
+		New(t).NotContains(caller, ".s:", "assembly frames of the runtime are not part of the Error Trace")
+	}
+}
+
+func TestInternalPackages(t *testing.T) {
+	for _, name := range []string{
+		"github.com/tisonkun/assert.(*Assertions).Equal",
+		"github.com/tisonkun/assert.EqualT[...]",
+		"github.com/tisonkun/assert/require.Equal",
+		"github.com/tisonkun/assert/mock.(*Mock).AssertExpectations",
+		"github.com/tisonkun/assert/cmpassert.Equal",
+		"github.com/tisonkun/assert/expvarassert.Equals",
+		"github.com/tisonkun/assert/promassert.MetricEquals",
+	} {
+		New(t).True(internalPackages[funcPackage(name)], name)
+	}
+	for _, name := range []string{
+		"example.com/assert.TestAssert",
+		"github.com/tisonkun/assert/suite.Run",
+		"github.com/tisonkun/assert_test.TestEqual",
+	} {
+		New(t).False(internalPackages[funcPackage(name)], name)
+	}
+}